@@ -0,0 +1,67 @@
+package gosbd
+
+import (
+	"github.com/gosbd/gosbd/internal/chunker"
+)
+
+// Chunker packs whole sentences into chunks of a bounded size,
+// e.g. to build the passages of a Retrieval Augmented Generation (RAG) index.
+type Chunker interface {
+	// Chunk takes a string of text and returns a slice of Chunk objects,
+	// where each Chunk holds consecutive sentences and its position in the original text.
+	Chunk(text string) []chunker.Chunk
+}
+
+// ChunkOption is a type that represents a function that modifies the chunker parameters.
+type ChunkOption func(*chunker.Params)
+
+// SizeInBytes measures chunks in bytes. This is the default.
+func SizeInBytes() ChunkOption {
+	return func(params *chunker.Params) {
+		params.Size = chunker.Bytes
+	}
+}
+
+// SizeInRunes measures chunks in runes.
+func SizeInRunes() ChunkOption {
+	return func(params *chunker.Params) {
+		params.Size = chunker.Runes
+	}
+}
+
+// SizeInTokens measures chunks with the given token counter.
+func SizeInTokens(count func(text string) int) ChunkOption {
+	return func(params *chunker.Params) {
+		params.Size = count
+	}
+}
+
+// OverlapSentences lets consecutive chunks share up to n sentences.
+func OverlapSentences(n int) ChunkOption {
+	return func(params *chunker.Params) {
+		params.OverlapSentences = n
+	}
+}
+
+// OverlapSize lets consecutive chunks share sentences whose size is up to n,
+// measured in the same unit as the chunks.
+func OverlapSize(n int) ChunkOption {
+	return func(params *chunker.Params) {
+		params.OverlapSize = n
+	}
+}
+
+// NewChunker creates a Chunker which segments texts with the given Segmenter and
+// packs the sentences into chunks whose size doesn't exceed maxSize.
+// A sentence larger than maxSize becomes a chunk of its own.
+// If both OverlapSentences and OverlapSize are given, the overlap satisfies both limits.
+func NewChunker(sg Segmenter, maxSize int, option ...ChunkOption) Chunker {
+	params := &chunker.Params{
+		Segmenter: sg,
+		MaxSize:   maxSize,
+	}
+	for _, opt := range option {
+		opt(params)
+	}
+	return chunker.NewChunker(params)
+}
@@ -1,6 +1,9 @@
 package gosbd
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/gosbd/gosbd/internal/cleaner"
 	"github.com/gosbd/gosbd/internal/lang"
 	"github.com/gosbd/gosbd/internal/processor"
@@ -20,35 +23,98 @@ type Segmenter interface {
 // Option is a type that represents a function that modifies the Options struct.
 type Option func(*segmenter.Params)
 
-// Clean cleans original tex,by default False
+// Clean enables the text cleaner, which is disabled by default.
+// The cleaner normalizes irregular newlines and spacing, drops tables of contents,
+// strips HTML tags and splits sentences that are joined without a space.
 func Clean() Option {
 	return func(params *segmenter.Params) {
-		params.Cleaner = cleaner.NewCleaner()
+		params.Clean = true
+	}
+}
+
+// RuneOffsets makes TextSpans report the offsets of the sentences in runes,
+// i.e. Unicode code points, in TextSpan.RuneStart and TextSpan.RuneEnd.
+func RuneOffsets() Option {
+	return func(params *segmenter.Params) {
+		params.Offsets |= segmenter.OffsetsRunes
+	}
+}
+
+// UTF16Offsets makes TextSpans report the offsets of the sentences in UTF-16 code units,
+// as used to index JavaScript strings, in TextSpan.UTF16Start and TextSpan.UTF16End.
+func UTF16Offsets() Option {
+	return func(params *segmenter.Params) {
+		params.Offsets |= segmenter.OffsetsUTF16
 	}
 }
 
+// ErrUnsupportedLanguage is matched by errors.Is for every error returned
+// when a segmenter is requested for a language code that isn't supported.
+var ErrUnsupportedLanguage = errors.New("gosbd: unsupported language")
+
+// UnsupportedLanguageError is returned by New when the given language code isn't supported.
+type UnsupportedLanguageError struct {
+	// Code is the language code that was requested.
+	Code string
+}
+
+func (e *UnsupportedLanguageError) Error() string {
+	return fmt.Sprintf("gosbd: unsupported language code %q, available codes are %v", e.Code, SupportedLanguages())
+}
+
+// Is reports whether target is ErrUnsupportedLanguage.
+func (e *UnsupportedLanguageError) Is(target error) bool {
+	return target == ErrUnsupportedLanguage
+}
+
+// SupportedLanguages returns the sorted ISO codes of the languages that can be
+// passed to NewSegmenter and New.
+func SupportedLanguages() []string {
+	return lang.Codes()
+}
+
 // NewSegmenter is a factory function that creates a new instance of a Segmenter.
 // It takes a language code as input and uses it to configure the Segmenter with
 // language-specific settings.
+// It panics if the language code isn't supported, use New to get an error instead.
 func NewSegmenter(langCode string, option ...Option) Segmenter {
-	cfg := lang.Lang(langCode)
-	punctuationReplacer := replacer.NewPunctuationReplacer()
-	betweenPunctuationReplacer := cfg.BetweenPunctuationReplacer
-	if betweenPunctuationReplacer == nil {
-		betweenPunctuationReplacer = replacer.NewBetweenPunctuation(punctuationReplacer)
+	sg, err := New(langCode, option...)
+	if err != nil {
+		panic(err)
 	}
+	return sg
+}
+
+// New is like NewSegmenter but returns an *UnsupportedLanguageError
+// instead of panicking if the language code isn't supported.
+func New(langCode string, option ...Option) (Segmenter, error) {
+	cfg, ok := lang.Lookup(langCode)
+	if !ok {
+		return nil, &UnsupportedLanguageError{Code: langCode}
+	}
+	// options may modify the word lists of the config,
+	// so they are applied to a copy to keep the shared config intact.
 	segmenterParams := &segmenter.Params{
-		Config: cfg,
-		Processor: processor.NewProcessor(processor.Params{
-			Lang:                       cfg,
-			ListItemReplacer:           replacer.NewListItemReplacer(),
-			AbbrReplacer:               replacer.NewAbbreviationReplacer(cfg),
-			PunctuationReplacer:        &punctuationReplacer,
-			BetweenPunctuationReplacer: betweenPunctuationReplacer,
-		}),
+		Config: cfg.Clone(),
 	}
 	for _, opt := range option {
 		opt(segmenterParams)
 	}
-	return segmenter.NewSegmenter(segmenterParams)
+	cfg = segmenterParams.Config
+	punctuationReplacer := replacer.NewPunctuationReplacer()
+	betweenPunctuationReplacer := cfg.BetweenPunctuationReplacer
+	if betweenPunctuationReplacer == nil {
+		betweenPunctuationReplacer = replacer.NewBetweenPunctuation(punctuationReplacer)
+	}
+	if segmenterParams.Clean {
+		segmenterParams.Cleaner = cleaner.NewCleaner(cfg)
+	}
+	segmenterParams.Processor = processor.NewProcessor(processor.Params{
+		Lang:                       cfg,
+		ListItemReplacer:           replacer.NewListItemReplacer(),
+		AbbrReplacer:               replacer.NewAbbreviationReplacer(cfg),
+		PunctuationReplacer:        &punctuationReplacer,
+		BetweenPunctuationReplacer: betweenPunctuationReplacer,
+	})
+	return segmenter.NewSegmenter(segmenterParams), nil
 }
@@ -0,0 +1,332 @@
+package gosbd_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+	"github.com/gosbd/gosbd/internal/segmenter"
+)
+
+func TestNew(t *testing.T) {
+	type args struct {
+		langCode string
+	}
+	tests := []struct {
+		args    args
+		wantErr bool
+	}{
+		{
+			args:    args{langCode: "en"},
+			wantErr: false,
+		},
+		{
+			args:    args{langCode: "xx"},
+			wantErr: true,
+		},
+		{
+			args:    args{langCode: ""},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args.langCode, func(t *testing.T) {
+			sg, err := gosbd.New(tt.args.langCode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				if sg == nil {
+					t.Fatal("New() returned nil segmenter")
+				}
+				return
+			}
+			if !errors.Is(err, gosbd.ErrUnsupportedLanguage) {
+				t.Errorf("errors.Is(%v, ErrUnsupportedLanguage) = false", err)
+			}
+			var langErr *gosbd.UnsupportedLanguageError
+			if !errors.As(err, &langErr) {
+				t.Fatalf("errors.As(%v, *UnsupportedLanguageError) = false", err)
+			}
+			if langErr.Code != tt.args.langCode {
+				t.Errorf("UnsupportedLanguageError.Code = %q, want %q", langErr.Code, tt.args.langCode)
+			}
+		})
+	}
+}
+
+func TestNewSegmenter_Panics(t *testing.T) {
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok || !errors.Is(err, gosbd.ErrUnsupportedLanguage) {
+			t.Errorf("NewSegmenter() panicked with %v, want ErrUnsupportedLanguage", r)
+		}
+	}()
+	gosbd.NewSegmenter("xx")
+}
+
+func TestSupportedLanguages(t *testing.T) {
+	want := []string{"am", "ar", "da", "de", "el", "en", "es", "fa", "fr", "hi", "hy", "it", "ja", "mr", "my", "nl", "pl", "ru", "ur", "zh"}
+	if got := gosbd.SupportedLanguages(); !reflect.DeepEqual(got, want) {
+		t.Errorf("SupportedLanguages() = %v, want %v", got, want)
+	}
+}
+
+func TestAbbreviationOptions(t *testing.T) {
+	type args struct {
+		text    string
+		options []gosbd.Option
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "default abbreviations",
+			args: args{
+				text: "The tank holds approx. 50 liters. It is full.",
+			},
+			want: []string{"The tank holds approx.", "50 liters.", "It is full."},
+		},
+		{
+			name: "add abbreviation",
+			args: args{
+				text:    "The tank holds approx. 50 liters. It is full.",
+				options: []gosbd.Option{gosbd.AddAbbreviations("approx.")},
+			},
+			want: []string{"The tank holds approx. 50 liters.", "It is full."},
+		},
+		{
+			name: "remove abbreviation",
+			args: args{
+				text:    "Please turn to fig. 5 in the manual.",
+				options: []gosbd.Option{gosbd.RemoveAbbreviations("fig")},
+			},
+			want: []string{"Please turn to fig.", "5 in the manual."},
+		},
+		{
+			name: "set abbreviations",
+			args: args{
+				text:    "Ask Mr. Smith about approx. 50 liters.",
+				options: []gosbd.Option{gosbd.SetAbbreviations("approx")},
+			},
+			want: []string{"Ask Mr.", "Smith about approx. 50 liters."},
+		},
+		{
+			name: "add pre-positive abbreviation",
+			args: args{
+				text:    "The report was signed by Sec. Smith yesterday.",
+				options: []gosbd.Option{gosbd.AddPrePositiveAbbreviations("Sec")},
+			},
+			want: []string{"The report was signed by Sec. Smith yesterday."},
+		},
+		{
+			name: "add number abbreviation",
+			args: args{
+				text:    "See para. 12 for details.",
+				options: []gosbd.Option{gosbd.AddNumberAbbreviations("para")},
+			},
+			want: []string{"See para. 12 for details."},
+		},
+		{
+			name: "add sentence starter",
+			args: args{
+				text:    "I live in the U.S. Everyone knows that.",
+				options: []gosbd.Option{gosbd.AddSentenceStarters("Everyone")},
+			},
+			want: []string{"I live in the U.S.", "Everyone knows that."},
+		},
+		{
+			name: "remove all sentence starters",
+			args: args{
+				text:    "I lived in the U.S. for years.",
+				options: []gosbd.Option{gosbd.RemoveSentenceStarters(englishSentenceStarters...)},
+			},
+			want: []string{"I lived in the U.S. for years."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("en", tt.args.options...)
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+// englishSentenceStarters are the default sentence starters of English.
+var englishSentenceStarters = strings.Fields(
+	"A Being Did For He How However I In It Millions More She That The There They We What When Where Who Why",
+)
+
+func TestAbbreviationOptions_KeepsDefaults(t *testing.T) {
+	sg := gosbd.NewSegmenter("en", gosbd.AddAbbreviations("approx"), gosbd.SetSentenceStarters())
+	text := "The tank holds approx. 50 liters. I lived in the U.S. for years."
+	want := []string{"The tank holds approx. 50 liters.", "I lived in the U.S. for years."}
+	if got := sg.Segment(text); !reflect.DeepEqual(got, want) {
+		t.Errorf("Segmenter.Segment() = %#v, want %#v", got, want)
+	}
+
+	// the options don't change the defaults of other segmenters.
+	text = "The tank holds approx. 50 liters. I live in the U.S. He lives there too."
+	want = []string{"The tank holds approx.", "50 liters.", "I live in the U.S.", "He lives there too."}
+	if got := gosbd.NewSegmenter("en").Segment(text); !reflect.DeepEqual(got, want) {
+		t.Errorf("Segmenter.Segment() = %#v, want %#v", got, want)
+	}
+}
+
+func TestOffsets(t *testing.T) {
+	type args struct {
+		lang    string
+		text    string
+		options []gosbd.Option
+	}
+	tests := []struct {
+		name string
+		args args
+		want []segmenter.TextSpan
+	}{
+		{
+			name: "byte offsets only",
+			args: args{
+				lang: "ja",
+				text: "これはペンです。それはマーカーです。",
+			},
+			want: []segmenter.TextSpan{
+				{Start: 0, End: 24, Sentence: "これはペンです。"},
+				{Start: 24, End: 54, Sentence: "それはマーカーです。"},
+			},
+		},
+		{
+			name: "rune offsets",
+			args: args{
+				lang:    "ja",
+				text:    "これはペンです。それはマーカーです。",
+				options: []gosbd.Option{gosbd.RuneOffsets()},
+			},
+			want: []segmenter.TextSpan{
+				{Start: 0, End: 24, Sentence: "これはペンです。", RuneStart: 0, RuneEnd: 8},
+				{Start: 24, End: 54, Sentence: "それはマーカーです。", RuneStart: 8, RuneEnd: 18},
+			},
+		},
+		{
+			name: "rune and utf-16 offsets",
+			args: args{
+				lang:    "ru",
+				text:    "Привет мир. Как дела?",
+				options: []gosbd.Option{gosbd.RuneOffsets(), gosbd.UTF16Offsets()},
+			},
+			want: []segmenter.TextSpan{
+				{Start: 0, End: 21, Sentence: "Привет мир.", RuneStart: 0, RuneEnd: 12, UTF16Start: 0, UTF16End: 12},
+				{Start: 21, End: 37, Sentence: "Как дела?", RuneStart: 12, RuneEnd: 21, UTF16Start: 12, UTF16End: 21},
+			},
+		},
+		{
+			name: "utf-16 offsets with surrogate pairs",
+			args: args{
+				lang:    "zh",
+				text:    "我很高兴😀。你好吗？",
+				options: []gosbd.Option{gosbd.RuneOffsets(), gosbd.UTF16Offsets()},
+			},
+			want: []segmenter.TextSpan{
+				{Start: 0, End: 19, Sentence: "我很高兴😀。", RuneStart: 0, RuneEnd: 6, UTF16Start: 0, UTF16End: 7},
+				{Start: 19, End: 31, Sentence: "你好吗？", RuneStart: 6, RuneEnd: 10, UTF16Start: 7, UTF16End: 11},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter(tt.args.lang, tt.args.options...)
+			if got := sg.TextSpans(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.TextSpans() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCleanTextSpans(t *testing.T) {
+	type args struct {
+		text    string
+		options []gosbd.Option
+	}
+	tests := []struct {
+		name string
+		args args
+		want []segmenter.TextSpan
+	}{
+		{
+			name: "newline in the middle of a sentence",
+			args: args{
+				text: "It was a cold \nnight in the city. It rained.",
+			},
+			want: []segmenter.TextSpan{
+				{Start: 0, End: 34, Sentence: "It was a cold night in the city."},
+				{Start: 34, End: 44, Sentence: "It rained."},
+			},
+		},
+		{
+			name: "html tags",
+			args: args{
+				text: "<p>This is a test.</p><p>Another one.</p>",
+			},
+			want: []segmenter.TextSpan{
+				{Start: 3, End: 25, Sentence: "This is a test."},
+				{Start: 25, End: 37, Sentence: "Another one."},
+			},
+		},
+		{
+			name: "no space between sentences",
+			args: args{
+				text: "Hello world.Today is Tuesday.",
+			},
+			want: []segmenter.TextSpan{
+				{Start: 0, End: 12, Sentence: "Hello world."},
+				{Start: 12, End: 29, Sentence: "Today is Tuesday."},
+			},
+		},
+		{
+			name: "quotations with rune offsets",
+			args: args{
+				text:    "``Café,'' she said. Bye.",
+				options: []gosbd.Option{gosbd.RuneOffsets()},
+			},
+			want: []segmenter.TextSpan{
+				{Start: 0, End: 21, Sentence: `"Café," she said.`, RuneStart: 0, RuneEnd: 20},
+				{Start: 21, End: 25, Sentence: "Bye.", RuneStart: 20, RuneEnd: 24},
+			},
+		},
+		{
+			name: "question mark between brackets",
+			args: args{
+				text: "<b>He said [who?] and left.</b> Bye.",
+			},
+			want: []segmenter.TextSpan{
+				{Start: 3, End: 32, Sentence: "He said [who?] and left."},
+				{Start: 32, End: 36, Sentence: "Bye."},
+			},
+		},
+		{
+			name: "added abbreviation without space",
+			args: args{
+				text:    "It costs approx.Ten dollars.",
+				options: []gosbd.Option{gosbd.AddAbbreviations("approx")},
+			},
+			want: []segmenter.TextSpan{
+				{Start: 0, End: 28, Sentence: "It costs approx.Ten dollars."},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("en", append(tt.args.options, gosbd.Clean())...)
+			if got := sg.TextSpans(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.TextSpans() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package chunker
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gosbd/gosbd/internal/segmenter"
+)
+
+type Segmenter interface {
+	TextSpans(text string) []segmenter.TextSpan
+}
+
+// SizeFunc measures the size of a text, e.g. in bytes, runes or tokens.
+type SizeFunc func(text string) int
+
+// Bytes measures the size of a text in bytes.
+func Bytes(text string) int {
+	return len(text)
+}
+
+// Runes measures the size of a text in runes.
+func Runes(text string) int {
+	return utf8.RuneCountInString(text)
+}
+
+// Chunk is a run of consecutive sentences of the original text.
+type Chunk struct {
+	// Start and End are the byte offsets of the chunk in the original text.
+	Start int
+	End   int
+	// Text is the sentences of the chunk separated by the whitespace between them.
+	// It is the original text between Start and End unless the segmenter cleans the text.
+	Text string
+	// Sentences holds the indices of the sentences in the chunk,
+	// as returned by Segmenter.TextSpans.
+	Sentences []int
+}
+
+type Chunker struct {
+	segmenter        Segmenter
+	maxSize          int
+	size             SizeFunc
+	overlapSentences int
+	overlapSize      int
+}
+
+// chunking holds the sentences of a text being chunked.
+type chunking struct {
+	text  string
+	spans []segmenter.TextSpan
+	// seps[i] is the whitespace following the sentence i.
+	seps []string
+	// offsets[i] is the size of the sentences and separators preceding the sentence i,
+	// so that the sizes are measured once per sentence.
+	offsets []int
+	sizes   []int
+}
+
+// Chunk packs the sentences of the text into chunks whose size doesn't exceed maxSize.
+// A sentence larger than maxSize is returned as a chunk of its own.
+func (c *Chunker) Chunk(text string) []Chunk {
+	spans := c.segmenter.TextSpans(text)
+	if len(spans) == 0 {
+		return nil
+	}
+	ck := c.newChunking(text, spans)
+	var chunks []Chunk
+	first := 0
+	for {
+		last := first
+		for last+1 < len(spans) && ck.size(first, last+1) <= c.maxSize {
+			last++
+		}
+		chunks = append(chunks, ck.newChunk(first, last))
+		if last == len(spans)-1 {
+			return chunks
+		}
+		first = c.nextFirst(ck, first, last)
+	}
+}
+
+func (c *Chunker) newChunking(text string, spans []segmenter.TextSpan) *chunking {
+	ck := &chunking{
+		text:    text,
+		spans:   spans,
+		seps:    make([]string, len(spans)),
+		offsets: make([]int, len(spans)),
+		sizes:   make([]int, len(spans)),
+	}
+	offset := 0
+	for i, span := range spans {
+		if i+1 < len(spans) {
+			ck.seps[i] = separator(text, span, spans[i+1])
+		}
+		ck.offsets[i] = offset
+		ck.sizes[i] = c.size(span.Sentence)
+		offset += ck.sizes[i] + c.size(ck.seps[i])
+	}
+	return ck
+}
+
+// nextFirst returns the index of the first sentence of the chunk following
+// the chunk of sentences first to last. It always makes progress.
+func (c *Chunker) nextFirst(ck *chunking, first, last int) int {
+	next := last + 1
+	if c.overlapSentences <= 0 && c.overlapSize <= 0 {
+		return next
+	}
+	for next-1 > first && c.canOverlap(ck, next-1, last) {
+		next--
+	}
+	// keep room for at least one new sentence in the next chunk
+	for next <= last && ck.size(next, last+1) > c.maxSize {
+		next++
+	}
+	return next
+}
+
+func (c *Chunker) canOverlap(ck *chunking, first, last int) bool {
+	if c.overlapSentences > 0 && last-first+1 > c.overlapSentences {
+		return false
+	}
+	if c.overlapSize > 0 && ck.size(first, last) > c.overlapSize {
+		return false
+	}
+	return true
+}
+
+// size returns the size of the chunk of sentences first to last.
+func (ck *chunking) size(first, last int) int {
+	return ck.offsets[last] + ck.sizes[last] - ck.offsets[first]
+}
+
+func (ck *chunking) newChunk(first, last int) Chunk {
+	var b strings.Builder
+	sentences := make([]int, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		b.WriteString(ck.spans[i].Sentence)
+		if i < last {
+			b.WriteString(ck.seps[i])
+		}
+		sentences = append(sentences, i)
+	}
+	return Chunk{
+		Start:     ck.spans[first].Start,
+		End:       sentenceEnd(ck.text, ck.spans[last]),
+		Text:      b.String(),
+		Sentences: sentences,
+	}
+}
+
+// separator returns the whitespace between the sentence of span and the sentence of next
+// in the original text, or a space if the sentence was cleaned or anything else,
+// e.g. markup removed by the cleaner, lies between them.
+func separator(text string, span, next segmenter.TextSpan) string {
+	end := span.Start + len(span.Sentence)
+	if end > next.Start || text[span.Start:end] != span.Sentence {
+		return " "
+	}
+	sep := text[end:next.Start]
+	if strings.TrimSpace(sep) != "" {
+		return " "
+	}
+	return sep
+}
+
+// sentenceEnd returns the end of the sentence without its trailing whitespace.
+func sentenceEnd(text string, span segmenter.TextSpan) int {
+	return span.Start + len(strings.TrimRightFunc(text[span.Start:span.End], unicode.IsSpace))
+}
+
+type Params struct {
+	Segmenter Segmenter
+	MaxSize   int
+	// Size measures chunks, it defaults to Bytes.
+	Size SizeFunc
+	// OverlapSentences is the maximum number of sentences shared by consecutive chunks.
+	OverlapSentences int
+	// OverlapSize is the maximum size of the sentences shared by consecutive chunks.
+	OverlapSize int
+}
+
+func NewChunker(params *Params) *Chunker {
+	size := params.Size
+	if size == nil {
+		size = Bytes
+	}
+	return &Chunker{
+		segmenter:        params.Segmenter,
+		maxSize:          params.MaxSize,
+		size:             size,
+		overlapSentences: params.OverlapSentences,
+		overlapSize:      params.OverlapSize,
+	}
+}
@@ -0,0 +1,126 @@
+package chunker_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+	"github.com/gosbd/gosbd/internal/chunker"
+)
+
+func TestChunker_Chunk(t *testing.T) {
+	const text = "One two three. Four five. Six seven eight nine. Ten."
+	type args struct {
+		maxSize int
+		options []gosbd.ChunkOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want []chunker.Chunk
+	}{
+		{
+			name: "without overlap",
+			args: args{maxSize: 26},
+			want: []chunker.Chunk{
+				{Start: 0, End: 25, Text: "One two three. Four five.", Sentences: []int{0, 1}},
+				{Start: 26, End: 52, Text: "Six seven eight nine. Ten.", Sentences: []int{2, 3}},
+			},
+		},
+		{
+			name: "sentence larger than max size",
+			args: args{maxSize: 15},
+			want: []chunker.Chunk{
+				{Start: 0, End: 14, Text: "One two three.", Sentences: []int{0}},
+				{Start: 15, End: 25, Text: "Four five.", Sentences: []int{1}},
+				{Start: 26, End: 47, Text: "Six seven eight nine.", Sentences: []int{2}},
+				{Start: 48, End: 52, Text: "Ten.", Sentences: []int{3}},
+			},
+		},
+		{
+			name: "overlap sentences",
+			args: args{maxSize: 35, options: []gosbd.ChunkOption{gosbd.OverlapSentences(1)}},
+			want: []chunker.Chunk{
+				{Start: 0, End: 25, Text: "One two three. Four five.", Sentences: []int{0, 1}},
+				{Start: 15, End: 47, Text: "Four five. Six seven eight nine.", Sentences: []int{1, 2}},
+				{Start: 26, End: 52, Text: "Six seven eight nine. Ten.", Sentences: []int{2, 3}},
+			},
+		},
+		{
+			name: "overlap size",
+			args: args{maxSize: 35, options: []gosbd.ChunkOption{gosbd.OverlapSize(12)}},
+			want: []chunker.Chunk{
+				{Start: 0, End: 25, Text: "One two three. Four five.", Sentences: []int{0, 1}},
+				{Start: 15, End: 47, Text: "Four five. Six seven eight nine.", Sentences: []int{1, 2}},
+				{Start: 48, End: 52, Text: "Ten.", Sentences: []int{3}},
+			},
+		},
+		{
+			name: "overlap is limited by max size",
+			args: args{maxSize: 26, options: []gosbd.ChunkOption{gosbd.OverlapSentences(2)}},
+			want: []chunker.Chunk{
+				{Start: 0, End: 25, Text: "One two three. Four five.", Sentences: []int{0, 1}},
+				{Start: 26, End: 52, Text: "Six seven eight nine. Ten.", Sentences: []int{2, 3}},
+			},
+		},
+		{
+			name: "token counter",
+			args: args{maxSize: 6, options: []gosbd.ChunkOption{gosbd.SizeInTokens(func(s string) int {
+				return len(strings.Fields(s))
+			})}},
+			want: []chunker.Chunk{
+				{Start: 0, End: 25, Text: "One two three. Four five.", Sentences: []int{0, 1}},
+				{Start: 26, End: 52, Text: "Six seven eight nine. Ten.", Sentences: []int{2, 3}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := gosbd.NewChunker(gosbd.NewSegmenter("en"), tt.args.maxSize, tt.args.options...)
+			if got := c.Chunk(text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Chunker.Chunk() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunker_ChunkRunes(t *testing.T) {
+	const text = "Привет мир. Как дела? Хорошо."
+	c := gosbd.NewChunker(gosbd.NewSegmenter("ru"), 21, gosbd.SizeInRunes())
+	want := []chunker.Chunk{
+		{Start: 0, End: 37, Text: "Привет мир. Как дела?", Sentences: []int{0, 1}},
+		{Start: 38, End: 51, Text: "Хорошо.", Sentences: []int{2}},
+	}
+	if got := c.Chunk(text); !reflect.DeepEqual(got, want) {
+		t.Errorf("Chunker.Chunk() = %#v, want %#v", got, want)
+	}
+}
+
+func TestChunker_ChunkClean(t *testing.T) {
+	const text = "<p>One.</p><p>Two two.</p>\n<p>Three.</p>"
+	c := gosbd.NewChunker(gosbd.NewSegmenter("en", gosbd.Clean()), 14)
+	want := []chunker.Chunk{
+		{Start: 3, End: 26, Text: "One. Two two.", Sentences: []int{0, 1}},
+		{Start: 30, End: 36, Text: "Three.", Sentences: []int{2}},
+	}
+	if got := c.Chunk(text); !reflect.DeepEqual(got, want) {
+		t.Errorf("Chunker.Chunk() = %#v, want %#v", got, want)
+	}
+}
+
+func TestChunker_ChunkSizeCalls(t *testing.T) {
+	const text = "One. Two. Three. Four. Five. Six. Seven. Eight."
+	calls := 0
+	c := gosbd.NewChunker(gosbd.NewSegmenter("en"), 1000, gosbd.SizeInTokens(func(s string) int {
+		calls++
+		return len(strings.Fields(s))
+	}))
+	if got := c.Chunk(text); len(got) != 1 || got[0].Text != text {
+		t.Fatalf("Chunker.Chunk() = %#v, want a single chunk of the whole text", got)
+	}
+	// a sentence and the separator following it are measured once
+	if want := 2 * 8; calls > want {
+		t.Errorf("size was called %d times, want at most %d", calls, want)
+	}
+}
@@ -1,12 +1,234 @@
 package cleaner
 
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+// Cleaner normalizes noisy text before it is segmented.
+// It is a port of the cleaner found in pySBD and pragmatic_segmenter.
 type Cleaner struct {
+	abbreviations map[string]struct{}
+}
+
+var (
+	// Rubular: http://rubular.com/r/3GiRiP2IbD
+	// NEWLINE_IN_MIDDLE_OF_SENTENCE_REGEX = r'(?<=\s)\n(?=([a-z]|\())'
+	newLineInMiddleOfSentenceRule = rule.NewRule(regexp.MustCompile(`(\s)\n([a-z(])`), "$1$2")
+	// Rubular: http://rubular.com/r/V57WnM9Zut
+	// NewLineInMiddleOfWordRule = Rule(r'\n(?=[a-zA-Z]{1,2}\n)', '')
+	newLineInMiddleOfWordLookahead = regexp.MustCompile(`\A[a-zA-Z]{1,2}\n`)
+	// Rubular: http://rubular.com/r/dMxp5MixFS
+	doubleNewLineWithSpaceRule = rule.NewRule(regexp.MustCompile(`\n \n`), "\r")
+	// Rubular: http://rubular.com/r/H6HOJeA8bq
+	doubleNewLineRule = rule.NewRule(regexp.MustCompile(`\n\n`), "\r")
+	// Rubular: http://rubular.com/r/FseyMiiYFT
+	// NewLineFollowedByPeriodRule = Rule(r'\n(?=\.(\s|\n))', '')
+	newLineFollowedByPeriodLookahead     = regexp.MustCompile(`\A\.\s`)
+	replaceNewlineWithCarriageReturnRule = rule.NewRule(regexp.MustCompile(`\n`), "\r")
+	escapedNewLineRule                   = rule.NewRule(regexp.MustCompile(`\\n`), "\n")
+	escapedCarriageReturnRule            = rule.NewRule(regexp.MustCompile(`\\r`), "\r")
+	typoEscapedNewLineRule               = rule.NewRule(regexp.MustCompile(`\\ n`), "\n")
+	typoEscapedCarriageReturnRule        = rule.NewRule(regexp.MustCompile(`\\ r`), "\r")
+	// Rubular: http://rubular.com/r/bAJrhyLNeZ
+	inlineFormattingRule = rule.NewRule(regexp.MustCompile(`{b\^&gt;\d*&lt;b\^}|{b\^>\d*<b\^}`), "")
+	// Rubular: http://rubular.com/r/8mc1ArOIGy
+	tableOfContentsRule = rule.NewRule(regexp.MustCompile(`\.{4,}\s*\d+-*\d*`), "\r")
+	// Rubular: http://rubular.com/r/DwNSuZrNtk
+	consecutivePeriodsRule = rule.NewRule(regexp.MustCompile(`\.{5,}`), " ")
+	// Rubular: http://rubular.com/r/IQ4TPfsbd8
+	consecutiveForwardSlashRule = rule.NewRule(regexp.MustCompile(`/{3}`), "")
+	backtickRule                = rule.NewRule(regexp.MustCompile("`"), "'")
+	quotationsFirstRule         = rule.NewRule(regexp.MustCompile(`''`), `"`)
+	quotationsSecondRule        = rule.NewRule(regexp.MustCompile("``"), `"`)
+	// Rubular: http://rubular.com/r/9d0OVOEJWj
+	htmlTagRule = rule.NewRule(
+		regexp.MustCompile(`</?\w+((\s+\w+(\s*=\s*(?:".*?"|'.*?'|[\^'">\s]+))?)+\s*|\s*)/?>`),
+		"",
+	)
+	// Rubular: http://rubular.com/r/XZVqMPJhea
+	escapedHTMLTagRule = rule.NewRule(regexp.MustCompile(`&lt;/?[^gt;]*gt;`), "")
+	htmlRules          = rule.Rules{htmlTagRule, escapedHTMLTagRule}
+	betweenBracketsRe  = regexp.MustCompile(`\[[^\]]*\]`)
+	questionMarkRule   = rule.NewRule(regexp.MustCompile(`\?`), "&ᓷ&")
+	// questionMarkPlaceholderRule reverts questionMarkRule as the processor does.
+	questionMarkPlaceholderRule = rule.NewRule(regexp.MustCompile(`&ᓷ&`), "?")
+)
+
+var (
+	// Rubular: http://rubular.com/r/6dt98uI76u
+	// NO_SPACE_BETWEEN_SENTENCES_REGEX = r'(?<=[a-z])\.(?=[A-Z])'
+	noSpaceBetweenSentencesRe = regexp.MustCompile(`([a-z])\.([A-Z])`)
+	// Rubular: http://rubular.com/r/l6KN6rH5XE
+	// NO_SPACE_BETWEEN_SENTENCES_DIGIT_REGEX = r'(?<=\d)\.(?=[A-Z])'
+	noSpaceBetweenSentencesDigitRe = regexp.MustCompile(`(\d)\.([A-Z])`)
+	abbreviationBeforePeriodRe     = regexp.MustCompile(`(?i)[a-z][a-z.]*$`)
+	urlEmailKeywords               = []string{"@", "http", ".com", "net", "www", "//"}
+)
+
+// Clean returns the cleaned text and the map of its offsets to the offsets of text.
+func (c *Cleaner) Clean(text string) (string, *OffsetMap) {
+	cl := newCleaning(text)
+	if text == "" {
+		return text, cl.m
+	}
+	c.removeAllNewlines(cl)
+	c.replaceDoubleNewlines(cl)
+	c.replaceNewlines(cl)
+	c.replaceEscapedNewlines(cl)
+	cl.applyRules(htmlRules...)
+	c.replacePunctuationInBrackets(cl)
+	cl.applyRules(inlineFormattingRule)
+	c.cleanQuotations(cl)
+	c.cleanTableOfContents(cl)
+	c.checkForNoSpaceInBetweenSentences(cl)
+	c.cleanConsecutiveCharacters(cl)
+	return cl.text, cl.m
+}
+
+// RestorePlaceholders replaces the placeholders left in a cleaned text with the punctuations
+// they stand for, as the processor does in the sentences, so that the sentences can be
+// aligned with the text. The returned map maps the offsets of the restored text to the original text.
+func RestorePlaceholders(cleaned string, m *OffsetMap) (string, *OffsetMap) {
+	cl := &cleaning{text: cleaned, m: m.clone()}
+	cl.applyRules(questionMarkPlaceholderRule)
+	return cl.text, cl.m
+}
+
+func (c *Cleaner) removeAllNewlines(cl *cleaning) {
+	cl.applyRules(newLineInMiddleOfSentenceRule)
+	removeNewlinesFollowedBy(cl, newLineInMiddleOfWordLookahead)
+}
+
+func (c *Cleaner) replaceDoubleNewlines(cl *cleaning) {
+	cl.applyRules(doubleNewLineWithSpaceRule, doubleNewLineRule)
+}
+
+func (c *Cleaner) replaceNewlines(cl *cleaning) {
+	removeNewlinesFollowedBy(cl, newLineFollowedByPeriodLookahead)
+	cl.applyRules(replaceNewlineWithCarriageReturnRule)
+}
+
+func (c *Cleaner) replaceEscapedNewlines(cl *cleaning) {
+	cl.applyRules(
+		escapedNewLineRule,
+		escapedCarriageReturnRule,
+		typoEscapedNewLineRule,
+		typoEscapedCarriageReturnRule,
+	)
+}
+
+func (c *Cleaner) replacePunctuationInBrackets(cl *cleaning) {
+	var edits []edit
+	for _, loc := range betweenBracketsRe.FindAllStringIndex(cl.text, -1) {
+		for _, m := range questionMarkRule.Pattern().FindAllStringIndex(cl.text[loc[0]:loc[1]], -1) {
+			edits = append(edits, edit{start: loc[0] + m[0], end: loc[0] + m[1], repl: questionMarkRule.Replacement()})
+		}
+	}
+	cl.apply(edits)
+}
+
+func (c *Cleaner) cleanQuotations(cl *cleaning) {
+	cl.applyRules(backtickRule, quotationsFirstRule, quotationsSecondRule)
+}
+
+func (c *Cleaner) cleanTableOfContents(cl *cleaning) {
+	cl.applyRules(tableOfContentsRule, consecutivePeriodsRule, consecutiveForwardSlashRule)
+}
+
+func (c *Cleaner) cleanConsecutiveCharacters(cl *cleaning) {
+	cl.applyRules(consecutivePeriodsRule, consecutiveForwardSlashRule)
+}
+
+// checkForNoSpaceInBetweenSentences inserts a space after periods which join
+// two sentences without any whitespace, e.g. "It was cold.The end.".
+// Words which look like URLs, e-mail addresses or abbreviations are left untouched.
+func (c *Cleaner) checkForNoSpaceInBetweenSentences(cl *cleaning) {
+	var edits []edit
+	for start := 0; start < len(cl.text); {
+		end := strings.IndexByte(cl.text[start:], ' ')
+		if end == -1 {
+			end = len(cl.text)
+		} else {
+			end += start
+		}
+		word := cl.text[start:end]
+		if (noSpaceBetweenSentencesRe.MatchString(word) || noSpaceBetweenSentencesDigitRe.MatchString(word)) &&
+			!c.isURLOrEmail(word) {
+			for _, i := range c.periodsJoiningSentences(word) {
+				edits = append(edits, edit{start: start + i + 1, end: start + i + 1, repl: " "})
+			}
+		}
+		start = end + 1
+	}
+	cl.apply(edits)
+}
+
+// periodsJoiningSentences returns the sorted offsets of the periods in word
+// which join two sentences.
+func (c *Cleaner) periodsJoiningSentences(word string) []int {
+	var periods []int
+	for _, re := range []*regexp.Regexp{noSpaceBetweenSentencesRe, noSpaceBetweenSentencesDigitRe} {
+		for _, m := range re.FindAllStringSubmatchIndex(word, -1) {
+			// m[3] is the end of the character preceding the period
+			if c.isAbbreviation(word[:m[3]]) {
+				continue
+			}
+			periods = append(periods, m[3])
+		}
+	}
+	sort.Ints(periods)
+	return periods
+}
+
+func (c *Cleaner) isAbbreviation(prefix string) bool {
+	candidate := strings.ToLower(abbreviationBeforePeriodRe.FindString(prefix))
+	if candidate == "" {
+		return false
+	}
+	if _, ok := c.abbreviations[candidate]; ok {
+		return true
+	}
+	if i := strings.LastIndex(candidate, "."); i != -1 {
+		_, ok := c.abbreviations[candidate[i+1:]]
+		return ok
+	}
+	return false
+}
+
+func (c *Cleaner) isURLOrEmail(word string) bool {
+	for _, k := range urlEmailKeywords {
+		if strings.Contains(word, k) {
+			return true
+		}
+	}
+	return false
 }
 
-func (c *Cleaner) Clean(text string) string {
-	panic("not implemented")
+// removeNewlinesFollowedBy removes every newline which is immediately followed
+// by text matching lookahead. lookahead must be anchored with \A.
+// This emulates the lookahead assertions used by pySBD, which RE2 doesn't support.
+func removeNewlinesFollowedBy(cl *cleaning, lookahead *regexp.Regexp) {
+	var edits []edit
+	for i := 0; i < len(cl.text); i++ {
+		if cl.text[i] != '\n' || !lookahead.MatchString(cl.text[i+1:]) {
+			continue
+		}
+		edits = append(edits, edit{start: i, end: i + 1})
+	}
+	cl.apply(edits)
 }
 
-func NewCleaner() *Cleaner {
-	return &Cleaner{}
+// NewCleaner returns a cleaner recognizing the abbreviations of cfg.
+// The abbreviations are copied, so later changes to cfg aren't reflected.
+func NewCleaner(cfg *processor.Config) *Cleaner {
+	abbreviations := make(map[string]struct{}, len(cfg.Abbreviation.Abbreviations))
+	for _, abbr := range cfg.Abbreviation.Abbreviations {
+		abbreviations[strings.ToLower(strings.TrimSpace(abbr))] = struct{}{}
+	}
+	return &Cleaner{abbreviations: abbreviations}
 }
@@ -0,0 +1,111 @@
+package cleaner
+
+import (
+	"testing"
+
+	"github.com/gosbd/gosbd/internal/processor"
+)
+
+func TestCleaner_Clean(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "newline in the middle of a sentence",
+			args: args{
+				text: "It was a cold \nnight in the city.",
+			},
+			want: "It was a cold night in the city.",
+		},
+		{
+			name: "newlines between list entries",
+			args: args{
+				text: "features\ncontact manager\nevents, activities\n",
+			},
+			want: "features\rcontact manager\revents, activities\r",
+		},
+		{
+			name: "double newlines",
+			args: args{
+				text: "First paragraph.\n\nSecond paragraph.\n \nThird paragraph.",
+			},
+			want: "First paragraph.\rSecond paragraph.\rThird paragraph.",
+		},
+		{
+			name: "escaped newlines",
+			args: args{
+				text: `First line.\nSecond line.\ rThird line.`,
+			},
+			want: "First line.\nSecond line.\rThird line.",
+		},
+		{
+			name: "html tags",
+			args: args{
+				text: "<h2 class=\"lined\">Hello</h2>\n<p>This is a test. Another test.</p>",
+			},
+			want: "Hello\rThis is a test. Another test.",
+		},
+		{
+			name: "escaped html tags",
+			args: args{
+				text: "&lt;b&gt;Bold&lt;/b&gt; text.",
+			},
+			want: "Bold text.",
+		},
+		{
+			name: "question mark between brackets",
+			args: args{
+				text: "He said [who?] and left.",
+			},
+			want: "He said [who&ᓷ&] and left.",
+		},
+		{
+			name: "quotations",
+			args: args{
+				text: "``Hello,'' she said.",
+			},
+			want: `"Hello," she said.`,
+		},
+		{
+			name: "table of contents",
+			args: args{
+				text: "Introduction.......... 1\nChapter One.......... 5-7\n",
+			},
+			want: "Introduction\r\rChapter One\r\r",
+		},
+		{
+			name: "no space between sentences",
+			args: args{
+				text: "Hello world.Today is Tuesday.Mr. Smith bought 1,000.That is a lot.",
+			},
+			want: "Hello world. Today is Tuesday. Mr. Smith bought 1,000. That is a lot.",
+		},
+		{
+			name: "no space between sentences in urls",
+			args: args{
+				text: "Visit http://example.com/Home.Page or mail me@site.Org today.",
+			},
+			want: "Visit http://example.com/Home.Page or mail me@site.Org today.",
+		},
+		{
+			name: "no space after abbreviation",
+			args: args{
+				text: "See the e.g.Example or the etc.Appendix below.",
+			},
+			want: "See the e.g.Example or the etc.Appendix below.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCleaner(processor.Standard())
+			if got, _ := c.Clean(tt.args.text); got != tt.want {
+				t.Errorf("Cleaner.Clean() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package cleaner
+
+import (
+	"regexp"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+// OffsetMap maps byte offsets of a cleaned text back to byte offsets of the original text.
+type OffsetMap struct {
+	segments []offsetSegment
+	origLen  int
+}
+
+// offsetSegment is a run of the cleaned text and the run of the original text it comes from.
+// The segments of a map are contiguous in both texts.
+// An identity segment is copied verbatim, so its offsets map one to one.
+type offsetSegment struct {
+	clean, cleanLen int
+	orig, origLen   int
+	identity        bool
+}
+
+func (s offsetSegment) cleanEnd() int {
+	return s.clean + s.cleanLen
+}
+
+func newOffsetMap(text string) *OffsetMap {
+	m := &OffsetMap{origLen: len(text)}
+	if len(text) > 0 {
+		m.segments = []offsetSegment{{cleanLen: len(text), origLen: len(text), identity: true}}
+	}
+	return m
+}
+
+func (m *OffsetMap) clone() *OffsetMap {
+	return &OffsetMap{
+		segments: append([]offsetSegment(nil), m.segments...),
+		origLen:  m.origLen,
+	}
+}
+
+// Start maps an offset of the cleaned text starting a range.
+// An offset inside text inserted or rewritten by the cleaner maps to the start of the text it replaced.
+func (m *OffsetMap) Start(pos int) int {
+	i := sort.Search(len(m.segments), func(i int) bool {
+		return m.segments[i].cleanEnd() > pos
+	})
+	if i == len(m.segments) {
+		return m.origLen
+	}
+	s := m.segments[i]
+	if s.identity {
+		return s.orig + pos - s.clean
+	}
+	return s.orig
+}
+
+// End maps an offset of the cleaned text ending a range.
+// An offset inside text inserted or rewritten by the cleaner maps to the end of the text it replaced.
+func (m *OffsetMap) End(pos int) int {
+	if pos <= 0 {
+		return 0
+	}
+	i := sort.Search(len(m.segments), func(i int) bool {
+		return m.segments[i].cleanEnd() >= pos
+	})
+	if i == len(m.segments) {
+		return m.origLen
+	}
+	s := m.segments[i]
+	if s.identity {
+		return s.orig + pos - s.clean
+	}
+	return s.orig + s.origLen
+}
+
+// edit replaces text[start:end] with repl.
+type edit struct {
+	start, end int
+	repl       string
+}
+
+// cleaning is a text being cleaned along with the map of its offsets to the original text.
+type cleaning struct {
+	text string
+	m    *OffsetMap
+}
+
+func newCleaning(text string) *cleaning {
+	return &cleaning{text: text, m: newOffsetMap(text)}
+}
+
+// applyRules applies the rules in order, recording their edits.
+func (c *cleaning) applyRules(rules ...rule.Rule) {
+	for _, r := range rules {
+		c.applyRegexp(r.Pattern(), r.Replacement())
+	}
+}
+
+// applyRegexp replaces the matches of re with the expanded template like regexp.ReplaceAllString.
+// Only the bytes which actually change are recorded as edited,
+// so that e.g. "$1. $2" is recorded as an inserted space.
+func (c *cleaning) applyRegexp(re *regexp.Regexp, template string) {
+	var edits []edit
+	for _, loc := range re.FindAllStringSubmatchIndex(c.text, -1) {
+		repl := string(re.ExpandString(nil, template, c.text, loc))
+		edits = append(edits, trimEdit(c.text, edit{start: loc[0], end: loc[1], repl: repl}))
+	}
+	c.apply(edits)
+}
+
+// trimEdit shrinks e to the bytes which differ from the text it replaces, keeping whole runes.
+func trimEdit(text string, e edit) edit {
+	old := text[e.start:e.end]
+	prefix := 0
+	for prefix < len(old) && prefix < len(e.repl) && old[prefix] == e.repl[prefix] {
+		prefix++
+	}
+	for prefix > 0 && prefix < len(old) && !utf8.RuneStart(old[prefix]) {
+		prefix--
+	}
+	suffix := 0
+	for suffix < len(old)-prefix && suffix < len(e.repl)-prefix &&
+		old[len(old)-1-suffix] == e.repl[len(e.repl)-1-suffix] {
+		suffix++
+	}
+	for suffix > 0 && suffix < len(old) && !utf8.RuneStart(old[len(old)-suffix]) {
+		suffix--
+	}
+	return edit{
+		start: e.start + prefix,
+		end:   e.end - suffix,
+		repl:  e.repl[prefix : len(e.repl)-suffix],
+	}
+}
+
+// apply applies edits sorted by offset and not overlapping, and updates the map accordingly.
+func (c *cleaning) apply(edits []edit) {
+	if len(edits) == 0 {
+		return
+	}
+	var (
+		buf  = make([]byte, 0, len(c.text))
+		last int
+	)
+	for _, e := range edits {
+		buf = append(buf, c.text[last:e.start]...)
+		buf = append(buf, e.repl...)
+		last = e.end
+	}
+	buf = append(buf, c.text[last:]...)
+	c.text = string(buf)
+	c.m.compose(edits)
+}
+
+// compose updates the map after edits are applied to the cleaned text.
+func (m *OffsetMap) compose(edits []edit) {
+	segments := m.split(edits)
+	composed := make([]offsetSegment, 0, len(segments)+len(edits))
+	j := 0
+	for _, e := range edits {
+		// segments up to the start of the edit, including the empty ones there, are kept
+		for j < len(segments) && segments[j].cleanEnd() <= e.start {
+			composed = append(composed, segments[j])
+			j++
+		}
+		replaced := offsetSegment{cleanLen: len(e.repl)}
+		// segments inside the edit are replaced
+		for j < len(segments) && segments[j].clean < e.end {
+			replaced.origLen += segments[j].origLen
+			j++
+		}
+		if replaced.cleanLen > 0 || replaced.origLen > 0 {
+			composed = append(composed, replaced)
+		}
+	}
+	composed = append(composed, segments[j:]...)
+	m.segments = normalizeSegments(composed)
+}
+
+// split splits the segments at the boundaries of the edits.
+// A rewritten segment can't be split exactly, so its original text is kept by the first part.
+func (m *OffsetMap) split(edits []edit) []offsetSegment {
+	segments := make([]offsetSegment, 0, len(m.segments)+2*len(edits))
+	i := 0
+	for _, s := range m.segments {
+		for ; i < 2*len(edits); i++ {
+			pos := edits[i/2].start
+			if i%2 == 1 {
+				pos = edits[i/2].end
+			}
+			if pos >= s.cleanEnd() {
+				break
+			}
+			if pos <= s.clean {
+				continue
+			}
+			head := s
+			head.cleanLen = pos - s.clean
+			s.clean, s.cleanLen = pos, s.cleanLen-head.cleanLen
+			if s.identity {
+				head.origLen = head.cleanLen
+				s.orig, s.origLen = s.orig+head.origLen, s.origLen-head.origLen
+			} else {
+				s.orig, s.origLen = s.orig+s.origLen, 0
+			}
+			segments = append(segments, head)
+		}
+		segments = append(segments, s)
+	}
+	return segments
+}
+
+// normalizeSegments recomputes the offsets of the segments and merges adjacent identity segments.
+func normalizeSegments(segments []offsetSegment) []offsetSegment {
+	var (
+		normalized []offsetSegment
+		clean      int
+		orig       int
+	)
+	for _, s := range segments {
+		if s.cleanLen == 0 && s.origLen == 0 {
+			continue
+		}
+		s.clean, s.orig = clean, orig
+		clean += s.cleanLen
+		orig += s.origLen
+		if n := len(normalized); n > 0 && s.identity && normalized[n-1].identity {
+			normalized[n-1].cleanLen += s.cleanLen
+			normalized[n-1].origLen += s.origLen
+			continue
+		}
+		normalized = append(normalized, s)
+	}
+	return normalized
+}
@@ -0,0 +1,78 @@
+package cleaner
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestOffsetMap(t *testing.T) {
+	type args struct {
+		text  string
+		steps []func(*cleaning)
+	}
+	deleteTags := func(cl *cleaning) { cl.applyRegexp(regexp.MustCompile(`</?p>`), "") }
+	insertSpace := func(cl *cleaning) { cl.applyRegexp(regexp.MustCompile(`([a-z])\.([A-Z])`), "$1. $2") }
+	doubleNewlines := func(cl *cleaning) { cl.applyRegexp(regexp.MustCompile(`\n\n`), "\r") }
+	tests := []struct {
+		name      string
+		args      args
+		want      string
+		wantStart []int
+		wantEnd   []int
+	}{
+		{
+			name:      "unchanged",
+			args:      args{text: "abc"},
+			want:      "abc",
+			wantStart: []int{0, 1, 2, 3},
+			wantEnd:   []int{0, 1, 2, 3},
+		},
+		{
+			name:      "deletions",
+			args:      args{text: "<p>ab</p>", steps: []func(*cleaning){deleteTags}},
+			want:      "ab",
+			wantStart: []int{3, 4, 9},
+			wantEnd:   []int{0, 4, 5},
+		},
+		{
+			name:      "insertion",
+			args:      args{text: "ab.Cd", steps: []func(*cleaning){insertSpace}},
+			want:      "ab. Cd",
+			wantStart: []int{0, 1, 2, 3, 3, 4, 5},
+			wantEnd:   []int{0, 1, 2, 3, 3, 4, 5},
+		},
+		{
+			name:      "replacement",
+			args:      args{text: "a\n\nb", steps: []func(*cleaning){doubleNewlines}},
+			want:      "a\rb",
+			wantStart: []int{0, 1, 3, 4},
+			wantEnd:   []int{0, 1, 3, 4},
+		},
+		{
+			name:      "composed steps",
+			args:      args{text: "<p>a.</p>\n\n<p>B.</p>", steps: []func(*cleaning){deleteTags, doubleNewlines}},
+			want:      "a.\rB.",
+			wantStart: []int{3, 4, 9, 14, 15, 20},
+			wantEnd:   []int{0, 4, 5, 11, 15, 16},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := newCleaning(tt.args.text)
+			for _, step := range tt.args.steps {
+				step(cl)
+			}
+			if cl.text != tt.want {
+				t.Fatalf("cleaned text = %q, want %q", cl.text, tt.want)
+			}
+			for pos := 0; pos <= len(cl.text); pos++ {
+				if got := cl.m.Start(pos); got != tt.wantStart[pos] {
+					t.Errorf("OffsetMap.Start(%d) = %d, want %d", pos, got, tt.wantStart[pos])
+				}
+				if got := cl.m.End(pos); got != tt.wantEnd[pos] {
+					t.Errorf("OffsetMap.End(%d) = %d, want %d", pos, got, tt.wantEnd[pos])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package lang
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+func newAmharic() *processor.Config {
+	cfg := processor.Standard()
+	cfg.BetweenPunctuationReplacer = newBetweenPunctuationReplacerScript(betweenPunctuationAmRegex, ethiopicPunctuationPlaceholder, multiPeriodAbbreviationAmRegex)
+	cfg.Abbreviation.Abbreviations = []string{"ቁ", "ት.ቤት", "ዓ.ም", "ዓ.ዓ", "ገ"}
+	cfg.Abbreviation.PrePositiveAbbreviations = nil
+	cfg.Abbreviation.NumberAbbreviations = nil
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrAlways
+	// The comma "፣" and the semicolon "፤" don't end a sentence.
+	// The terminators are often not followed by a space, e.g. "እንደምን አለህ፧መልካም ቀን ይሁንልህ።",
+	// which the sentence boundary rules 7 and 9 split on anyway.
+	addSentenceTerminators(cfg, "።", "፧", "፨")
+	cfg.SubSymbolsRules.All = append(cfg.SubSymbolsRules.All, fullStopAmRule, questionMarkAmRule, paragraphSeparatorAmRule)
+	cfg.SentenceStarters = nil
+	return cfg
+}
+
+var (
+	// abbreviations written with periods, e.g. "ዓ.ም."
+	multiPeriodAbbreviationAmRegex = regexp.MustCompile(`(?:^|[\s(«"“])\p{Ethiopic}{1,3}(?:\.\p{Ethiopic}{1,3})+[.∯]`)
+	betweenPunctuationAmRegex      = regexp.MustCompile(`«[^«»]*»|“[^“”]*”|"[^"]*"|\([^()]*\)`)
+
+	ethiopicPunctuationPlaceholder = strings.NewReplacer("።", "&ᓾ&", "፧", "&ᓿ&", "፨", "&ᔀ&")
+	fullStopAmRule                 = rule.NewRule(regexp.MustCompile(`&ᓾ&`), "።")
+	questionMarkAmRule             = rule.NewRule(regexp.MustCompile(`&ᓿ&`), "፧")
+	paragraphSeparatorAmRule       = rule.NewRule(regexp.MustCompile(`&ᔀ&`), "፨")
+)
@@ -0,0 +1,84 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Amharic(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Terminators not followed by a space",
+			args: args{
+				text: "እንደምን አለህ፧መልካም ቀን ይሁንልህ።እባክሽ ያልሽዉን ድገሚልኝ።",
+			},
+			want: []string{"እንደምን አለህ፧", "መልካም ቀን ይሁንልህ።", "እባክሽ ያልሽዉን ድገሚልኝ።"},
+		},
+		{
+			name: "2) Full stop and question mark",
+			args: args{
+				text: "ሰላም ነው። ደህና ነህ?",
+			},
+			want: []string{"ሰላም ነው።", "ደህና ነህ?"},
+		},
+		{
+			name: "3) Comma and semicolon",
+			args: args{
+				text: "ቡና፣ ሻይ እና ወተት ገዛሁ፤ ከዚያ ወደ ቤት ሄድኩ። ደክሞኝ ነበር።",
+			},
+			want: []string{"ቡና፣ ሻይ እና ወተት ገዛሁ፤ ከዚያ ወደ ቤት ሄድኩ።", "ደክሞኝ ነበር።"},
+		},
+		{
+			name: "4) Paragraph separator",
+			args: args{
+				text: "የመጀመሪያ ክፍል፨ሁለተኛ ክፍል።",
+			},
+			want: []string{"የመጀመሪያ ክፍል፨", "ሁለተኛ ክፍል።"},
+		},
+		{
+			name: "5) Question inside guillemets",
+			args: args{
+				text: "«ደህና ነህ፧» አለችው። መለሰላት።",
+			},
+			want: []string{"«ደህና ነህ፧» አለችው።", "መለሰላት።"},
+		},
+		{
+			name: "6) Multi-period abbreviation",
+			args: args{
+				text: "በ1990 ዓ.ም. ተወለደ። አሁን መምህር ነው።",
+			},
+			want: []string{"በ1990 ዓ.ም. ተወለደ።", "አሁን መምህር ነው።"},
+		},
+		{
+			name: "7) Decimal number",
+			args: args{
+				text: "ዋጋው 3.5 ብር ነው። ርካሽ ነው።",
+			},
+			want: []string{"ዋጋው 3.5 ብር ነው።", "ርካሽ ነው።"},
+		},
+		{
+			name: "8) Latin acronym followed by a word",
+			args: args{
+				text: "እሱ በ U.S. ለአሥር ዓመታት ኖሯል። አገሩን ይወዳል።",
+			},
+			want: []string{"እሱ በ U.S. ለአሥር ዓመታት ኖሯል።", "አገሩን ይወዳል።"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("am")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package lang
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+func newArabic() *processor.Config {
+	cfg := processor.Standard()
+	cfg.BetweenPunctuationReplacer = newBetweenPunctuationReplacerArabic()
+	cfg.Abbreviation.Abbreviations = []string{"ا", "ا.د", "ا.ش.ا", "أ", "أ.د", "إلخ", "ت.ب", "ج.ب", "ج.م.ع", "جم", "د", "س.ت", "سم", "ص.ب", "كج", "كلم", "م", "م.ب", "ه"}
+	cfg.Abbreviation.PrePositiveAbbreviations = nil
+	cfg.Abbreviation.NumberAbbreviations = nil
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrAlways
+	addSentenceTerminators(cfg, "؟", "۔")
+	cfg.SubSymbolsRules.All = append(cfg.SubSymbolsRules.All, arabicQuestionMarkRule, arabicFullStopRule)
+	cfg.SentenceStarters = nil
+	return cfg
+}
+
+var (
+	// abbreviations written with periods, e.g. "ج.م.ع."
+	multiPeriodAbbreviationArRegex = regexp.MustCompile(`(?:^|[\s(«»"“”])\p{Arabic}{1,3}(?:\.\p{Arabic}{1,3})+[.∯]`)
+	// quotes may be paired either way in right-to-left text, e.g. "«...»" or "»...«"
+	betweenPunctuationArRegex = regexp.MustCompile(`«[^«»]*»|»[^«»]*«|“[^“”]*”|”[^“”]*“|"[^"]*"|\([^()]*\)`)
+)
+
+// The Arabic-script punctuations are replaced with placeholders when they don't end a sentence.
+// A colon, which ends a sentence in Persian, reuses the placeholder of the sub symbols rules.
+var (
+	arabicPunctuationPlaceholder = strings.NewReplacer("؟", "&ᓹ&", "۔", "&ᓺ&", ":", "♭")
+	arabicQuestionMarkRule       = rule.NewRule(regexp.MustCompile(`&ᓹ&`), "؟")
+	arabicFullStopRule           = rule.NewRule(regexp.MustCompile(`&ᓺ&`), "۔")
+)
+
+// newBetweenPunctuationReplacerArabic returns the replacer shared by the languages written in the Arabic script.
+func newBetweenPunctuationReplacerArabic() processor.BetweenPunctuationReplacer {
+	return newBetweenPunctuationReplacerScript(betweenPunctuationArRegex, arabicPunctuationPlaceholder, multiPeriodAbbreviationArRegex)
+}
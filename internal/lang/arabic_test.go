@@ -0,0 +1,119 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Arabic(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Question mark and period",
+			args: args{
+				text: "سؤال وجواب: ماذا حدث بعد الانتخابات الايرانية؟ طرح الكثير من التساؤلات غداة ظهور نتائج الانتخابات الرئاسية الايرانية التي أججت مظاهرات واسعة واعمال عنف بين المحتجين على النتائج ورجال الامن. يقدم هنا عدد من المراسلين والمحللين توضيحا لما قد يحصل في الايام المقبلة من تطورات قد تنعكس على إيران داخليا وخارجيا.",
+			},
+			want: []string{"سؤال وجواب: ماذا حدث بعد الانتخابات الايرانية؟", "طرح الكثير من التساؤلات غداة ظهور نتائج الانتخابات الرئاسية الايرانية التي أججت مظاهرات واسعة واعمال عنف بين المحتجين على النتائج ورجال الامن.", "يقدم هنا عدد من المراسلين والمحللين توضيحا لما قد يحصل في الايام المقبلة من تطورات قد تنعكس على إيران داخليا وخارجيا."},
+		},
+		{
+			name: "2) Comma in the middle of a sentence",
+			args: args{
+				text: "قال أحمد، إنه سيأتي غدا. ثم ذهب.",
+			},
+			want: []string{"قال أحمد، إنه سيأتي غدا.", "ثم ذهب."},
+		},
+		{
+			name: "3) Commas between list items",
+			args: args{
+				text: "ذهب أحمد، وعلي، ومحمد إلى السوق. كان الطعام لذيذا.",
+			},
+			want: []string{"ذهب أحمد، وعلي، ومحمد إلى السوق.", "كان الطعام لذيذا."},
+		},
+		{
+			name: "4) Colon between numbers",
+			args: args{
+				text: "بدأ الاجتماع في الساعة 09:30 صباحا. انتهى بعد ساعتين.",
+			},
+			want: []string{"بدأ الاجتماع في الساعة 09:30 صباحا.", "انتهى بعد ساعتين."},
+		},
+		{
+			name: "5) Colon in a URL",
+			args: args{
+				text: "زوروا موقعنا https://example.com للمزيد. شكرا لكم.",
+			},
+			want: []string{"زوروا موقعنا https://example.com للمزيد.", "شكرا لكم."},
+		},
+		{
+			name: "6) Arabic full stop",
+			args: args{
+				text: "ذهبت إلى السوق۔ اشتريت الخبز۔",
+			},
+			want: []string{"ذهبت إلى السوق۔", "اشتريت الخبز۔"},
+		},
+		{
+			name: "7) Question mark and exclamation point",
+			args: args{
+				text: "هل تعرف الطريق؟ نعم! أعرفه جيدا.",
+			},
+			want: []string{"هل تعرف الطريق؟", "نعم!", "أعرفه جيدا."},
+		},
+		{
+			name: "8) Question inside guillemets",
+			args: args{
+				text: "قالت «هل أنت بخير؟» ثم ابتسمت. لم أجب.",
+			},
+			want: []string{"قالت «هل أنت بخير؟» ثم ابتسمت.", "لم أجب."},
+		},
+		{
+			name: "9) Question inside right-to-left guillemets",
+			args: args{
+				text: "قالت »هل أنت بخير؟« ثم ابتسمت. لم أجب.",
+			},
+			want: []string{"قالت »هل أنت بخير؟« ثم ابتسمت.", "لم أجب."},
+		},
+		{
+			name: "10) Question inside right-to-left quotes",
+			args: args{
+				text: "قالت ”هل أنت بخير؟“ ثم ابتسمت. لم أجب.",
+			},
+			want: []string{"قالت ”هل أنت بخير؟“ ثم ابتسمت.", "لم أجب."},
+		},
+		{
+			name: "11) Abbreviation",
+			args: args{
+				text: "زار د. أحمد المستشفى. كان مشغولا.",
+			},
+			want: []string{"زار د. أحمد المستشفى.", "كان مشغولا."},
+		},
+		{
+			name: "12) Multi-period abbreviation",
+			args: args{
+				text: "تقع الشركة في ج.م.ع. منذ عام 1990. هذا مثال.",
+			},
+			want: []string{"تقع الشركة في ج.م.ع. منذ عام 1990.", "هذا مثال."},
+		},
+		{
+			name: "13) Latin acronym followed by a word",
+			args: args{
+				text: "يعيش في U.S. منذ عشر سنوات. يحب هذا البلد.",
+			},
+			want: []string{"يعيش في U.S. منذ عشر سنوات.", "يحب هذا البلد."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("ar")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package lang
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+func newArmenian() *processor.Config {
+	cfg := processor.Standard()
+	cfg.BetweenPunctuationReplacer = newBetweenPunctuationReplacerScript(betweenPunctuationHyRegex, fullStopPlaceholderHy, nil)
+	cfg.Abbreviation.Abbreviations = nil
+	cfg.Abbreviation.PrePositiveAbbreviations = nil
+	cfg.Abbreviation.NumberAbbreviations = nil
+	// A sentence ends with the full stop "։", which is often typed as a colon as in pySBD.
+	// The question mark "՞" and the emphasis mark "՜" are placed over a vowel inside the word,
+	// so they don't end a sentence, e.g. "Ո՞ւր ես գնում։"
+	addSentenceTerminators(cfg, "։", ":")
+	cfg.NonSentenceBoundaryRules = rule.Rules{
+		colonNotFollowedBySpaceRule,
+		periodHyRule,
+	}
+	cfg.SubSymbolsRules.All = append(cfg.SubSymbolsRules.All, fullStopHyRule)
+	cfg.SentenceStarters = nil
+	return cfg
+}
+
+var (
+	// a period is typed for the mijaket "․", which separates clauses like a semicolon
+	periodHyRule              = rule.NewRule(regexp.MustCompile(`\.`), "∯")
+	betweenPunctuationHyRegex = regexp.MustCompile(`«[^«»]*»|“[^“”]*”|"[^"]*"|\([^()]*\)`)
+
+	fullStopPlaceholderHy = strings.NewReplacer("։", "&ᓽ&", ":", "♭")
+	fullStopHyRule        = rule.NewRule(regexp.MustCompile(`&ᓽ&`), "։")
+)
@@ -0,0 +1,98 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Armenian(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Colon typed for the full stop",
+			args: args{
+				text: "Ի՞նչ ես մտածում: Ոչինչ:",
+			},
+			want: []string{"Ի՞նչ ես մտածում:", "Ոչինչ:"},
+		},
+		{
+			name: "2) Ellipsis",
+			args: args{
+				text: "Ապրիլի 24-ին սկսեց անձրևել...Այդպես էի գիտեի:",
+			},
+			want: []string{"Ապրիլի 24-ին սկսեց անձրևել...Այդպես էի գիտեի:"},
+		},
+		{
+			name: "3) Period typed for the mijaket",
+			args: args{
+				text: "Այսպիսով` մոտենում ենք ավարտին: Տրամաբանությյունը հետևյալն է. պարզություն և աշխատանք:",
+			},
+			want: []string{"Այսպիսով` մոտենում ենք ավարտին:", "Տրամաբանությյունը հետևյալն է. պարզություն և աշխատանք:"},
+		},
+		{
+			name: "4) Question mark inside a word",
+			args: args{
+				text: "Ո՞ւր ես գնում։ Տուն եմ գնում։",
+			},
+			want: []string{"Ո՞ւր ես գնում։", "Տուն եմ գնում։"},
+		},
+		{
+			name: "5) Question mark inside a word in the middle of a sentence",
+			args: args{
+				text: "Ինչո՞ւ ես ուշացել այսօր։ Ավտոբուսը չեկավ։",
+			},
+			want: []string{"Ինչո՞ւ ես ուշացել այսօր։", "Ավտոբուսը չեկավ։"},
+		},
+		{
+			name: "6) Emphasis mark inside a word",
+			args: args{
+				text: "Ա՜խ, ինչ գեղեցիկ է։ Շատ եմ սիրում։",
+			},
+			want: []string{"Ա՜խ, ինչ գեղեցիկ է։", "Շատ եմ սիրում։"},
+		},
+		{
+			name: "7) Full stop inside guillemets",
+			args: args{
+				text: "«Գնում եմ։» ասաց նա։ Հետո գնաց։",
+			},
+			want: []string{"«Գնում եմ։» ասաց նա։", "Հետո գնաց։"},
+		},
+		{
+			name: "8) Colon between numbers",
+			args: args{
+				text: "Հանդիպումը ժամը 10:30-ին է։ Մի ուշացիր։",
+			},
+			want: []string{"Հանդիպումը ժամը 10:30-ին է։", "Մի ուշացիր։"},
+		},
+		{
+			name: "9) Abbreviation",
+			args: args{
+				text: "Նա ծնվել է 1990 թ. Երևանում։ Հիմա ապրում է Մոսկվայում։",
+			},
+			want: []string{"Նա ծնվել է 1990 թ. Երևանում։", "Հիմա ապրում է Մոսկվայում։"},
+		},
+		{
+			name: "10) Acronym followed by a lower case word",
+			args: args{
+				text: "Նա տասը տարի ապրում է U.S. երկրում։ Նրան դուր է գալիս այդ երկիրը։",
+			},
+			want: []string{"Նա տասը տարի ապրում է U.S. երկրում։", "Նրան դուր է գալիս այդ երկիրը։"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("hy")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package lang
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+func newBurmese() *processor.Config {
+	cfg := processor.Standard()
+	cfg.BetweenPunctuationReplacer = newBetweenPunctuationReplacerScript(betweenPunctuationMyRegex, sectionPlaceholderMy, nil)
+	cfg.Abbreviation.Abbreviations = nil
+	cfg.Abbreviation.PrePositiveAbbreviations = nil
+	cfg.Abbreviation.NumberAbbreviations = nil
+	cfg.Numbers = numbersWithDigits("၀-၉")
+	// Burmese has no letter case and rarely puts a space after a sentence,
+	// the sentence boundary rules 7 and 9 split on "။" regardless of the following text.
+	// The comma "၊" doesn't end a sentence. Unlike pySBD, "၏" isn't a terminator,
+	// as it is mostly used as a possessive particle, e.g. "သူ၏ အိမ်".
+	addSentenceTerminators(cfg, "။")
+	cfg.SubSymbolsRules.All = append(cfg.SubSymbolsRules.All, sectionMyRule)
+	cfg.SentenceStarters = nil
+	return cfg
+}
+
+var (
+	betweenPunctuationMyRegex = regexp.MustCompile(`“[^“”]*”|"[^"]*"|\([^()]*\)`)
+
+	sectionPlaceholderMy = strings.NewReplacer("။", "&ᔁ&")
+	sectionMyRule        = rule.NewRule(regexp.MustCompile(`&ᔁ&`), "။")
+)
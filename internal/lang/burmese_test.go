@@ -0,0 +1,84 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Burmese(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) No space between sentences",
+			args: args{
+				text: "ခင္ဗ်ားနာမည္ဘယ္လိုေခၚလဲ။၇ွင္ေနေကာင္းလား။",
+			},
+			want: []string{"ခင္ဗ်ားနာမည္ဘယ္လိုေခၚလဲ။", "၇ွင္ေနေကာင္းလား။"},
+		},
+		{
+			name: "2) Space between sentences",
+			args: args{
+				text: "ကျွန်တော် ကျောင်းသွားတယ်။ မိုးရွာနေတယ်။",
+			},
+			want: []string{"ကျွန်တော် ကျောင်းသွားတယ်။", "မိုးရွာနေတယ်။"},
+		},
+		{
+			name: "3) Comma",
+			args: args{
+				text: "ကျွန်တော်သည် ပန်းသီး၊ လိမ္မော်သီးနှင့် ငှက်ပျောသီး ဝယ်ခဲ့သည်။ အိမ်ပြန်ခဲ့သည်။",
+			},
+			want: []string{"ကျွန်တော်သည် ပန်းသီး၊ လိမ္မော်သီးနှင့် ငှက်ပျောသီး ဝယ်ခဲ့သည်။", "အိမ်ပြန်ခဲ့သည်။"},
+		},
+		{
+			name: "4) Possessive particle",
+			args: args{
+				text: "သူ၏ အိမ်သည် ကြီးသည်။ လှပသည်။",
+			},
+			want: []string{"သူ၏ အိမ်သည် ကြီးသည်။", "လှပသည်။"},
+		},
+		{
+			name: "5) Decimal number in Burmese digits",
+			args: args{
+				text: "ဈေးနှုန်းမှာ ၃.၅ ကျပ်ဖြစ်သည်။စျေးပေါသည်။",
+			},
+			want: []string{"ဈေးနှုန်းမှာ ၃.၅ ကျပ်ဖြစ်သည်။", "စျေးပေါသည်။"},
+		},
+		{
+			name: "6) Terminator inside quotes",
+			args: args{
+				text: "သူက “ငါ လာမယ်။” လို့ ပြောတယ်။ ပြီးတော့ ထွက်သွားတယ်။",
+			},
+			want: []string{"သူက “ငါ လာမယ်။” လို့ ပြောတယ်။", "ပြီးတော့ ထွက်သွားတယ်။"},
+		},
+		{
+			name: "7) Question mark and exclamation point",
+			args: args{
+				text: "နေကောင်းလား? ကောင်းပါတယ်!",
+			},
+			want: []string{"နေကောင်းလား?", "ကောင်းပါတယ်!"},
+		},
+		{
+			name: "8) Latin acronym followed by a word",
+			args: args{
+				text: "သူသည် U.S. မှာ ဆယ်နှစ် နေခဲ့သည်။ သူ ဒီနိုင်ငံကို ကြိုက်သည်။",
+			},
+			want: []string{"သူသည် U.S. မှာ ဆယ်နှစ် နေခဲ့သည်။", "သူ ဒီနိုင်ငံကို ကြိုက်သည်။"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("my")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package lang
+
+import (
+	"regexp"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+func newDanish() *processor.Config {
+	cfg := processor.Standard()
+	cfg.Abbreviation.Abbreviations = []string{"adm", "adr", "afd", "afs", "al", "alm", "ang", "ank", "anm", "ann", "ansvh", "apr", "arr", "ass", "att", "aud", "aug", "aut", "bd", "bdt", "bet", "bhp", "bil", "bk", "bl.a", "bla", "bm", "bogh", "bot", "br", "bsp", "bto", "bygn", "ca", "cand", "cm", "co", "d", "d.d", "d.m", "d.s", "d.s.s", "d.v.s", "d.y", "dagl", "dat", "dav", "def", "dek", "dep", "desl", "diam", "dir", "disp", "distr", "div", "dr", "ds", "dvs", "e.b", "e.kr", "e.l", "e.o", "e.v.t", "eftf", "eftm", "egl", "eks", "eksam", "ekskl", "eksp", "ekspl", "emer", "endv", "eng", "enk", "etc", "eur", "evt", "exam", "f", "f.eks", "f.kr", "f.m", "f.n", "f.o", "f.o.m", "f.s.v", "f.t", "f.v.t", "f.å", "fa", "fakt", "feb", "fec", "ff", "fg", "fhv", "fig", "fl", "flg", "fm", "fmd", "forb", "foreg", "foren", "forf", "forh", "fork", "forr", "fors", "forsk", "forts", "fp", "fr", "frk", "fru", "fuldm", "fung", "fys", "fær", "g", "g.d", "g.m", "gd", "gdr", "gg", "gh", "gl", "gn", "gns", "gr", "grdl", "gross", "h.a", "h.c", "hdl", "henh", "henv", "hf", "hft", "hhv", "hort", "hosp", "hpl", "hr", "hrs", "hum", "i.e", "ib", "ibid", "ifm", "ill", "indb", "indreg", "ing", "inkl", "insp", "instr", "isl", "istf", "jan", "jf", "jfr", "jnr", "jr", "jul", "jun", "jur", "jvf", "kal", "kap", "kat", "kbh", "kem", "kgl", "kin", "kl", "kld", "km/t", "knsp", "komm", "kons", "korr", "kp", "kr", "kst", "kt", "ktr", "kv", "kvt", "l.c", "lab", "lb", "lb.nr", "lejl", "lgd", "lic", "lign", "lin", "ling.merc", "litt", "loc.cit", "lok", "lrs", "ltr", "m.a.o", "m.a.s", "m.fl", "m.m", "m.v", "m.v.h", "maks", "md", "mdr", "mdtl", "mezz", "mfl", "mg", "mgl", "mht", "mia", "mik", "mio", "modt", "ms", "mul", "mv", "mvh", "n.br", "n.f", "nat", "ned", "nedenn", "nedenst", "nl", "nr", "nto", "nuv", "o.a", "o.fl", "o.h", "o.l", "o.lign", "o.m.a", "o.s.fr", "obl", "obs", "odont", "oecon", "off", "ofl", "omg", "omkr", "omr", "omtr", "opg", "opl", "opr", "org", "orig", "osv", "ovenst", "overs", "ovf", "p", "p.a", "p.b.a", "p.b.v", "p.c", "p.m", "p.m.v", "p.n", "p.p", "p.p.s", "p.s", "p.t", "p.v.a", "p.v.c", "pag", "pcs", "pct", "pd", "pens", "pft", "pg", "pga", "pgl", "pinx", "pk", "pkt", "polit", "polyt", "pp", "ppm", "pr", "prc", "priv", "prod", "prof", "pron", "præd", "præf", "præp", "præs", "præt", "psych", "pt", "pæd", "q.e.d", "rad", "red", "ref", "reg", "regn", "rel", "rep", "repr", "rk", "russ", "s", "s.br", "s.d", "s.e", "s.f", "s.m.b.a", "s.u", "s.å", "s/s", "sb", "sc", "scient", "sek", "sekr", "sign", "sj", "skr", "skt", "slutn", "sml", "smp", "snr", "soc", "soc.dem", "sp", "spec", "spm", "spr", "spsk", "st", "statsaut", "stk", "str", "stud", "subj", "subst", "suff", "sup", "suppl", "sv", "såk", "sædv", "t.h", "t.o.m", "t.v", "tab", "td", "tdl", "tdr", "techn", "tekn", "temp", "th", "tidl", "tilf", "tilh", "till", "tilsv", "tjg", "tlf", "tlgr", "tr", "trp", "tv", "ty", "u.p", "u.st", "u.å", "uafh", "ub", "ubesk", "ubest", "udd", "udg", "ugtl", "ult", "underv", "uu", "v.f", "v.s.a", "v.s.s", "v.v", "vedk", "vedl", "vejl", "vet", "vh", "vha", "vm", "vol", "vs", "vsa", "vsp", "vær", "zool", "år", "årg", "årh"}
+	cfg.Abbreviation.PrePositiveAbbreviations = []string{"adm", "dr", "fru", "frk", "hr", "prof", "skt", "st"}
+	cfg.Abbreviation.NumberAbbreviations = []string{"kap", "nr", "p", "pag", "pp", "s", "stk", "tab"}
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrLowerCase
+	cfg.Numbers.OrdinalRules = rule.Rules{
+		ordinalNumberBeforeLowerCaseRule,
+		periodInDateRuleDa,
+	}
+	cfg.SentenceStarters = nil
+	return cfg
+}
+
+var (
+	// months are capitalized in older texts, e.g. "den 5. Maj"
+	periodInDateRuleDa = rule.NewRule(
+		regexp.MustCompile(`(\d)\.(\s*(?i:januar|februar|marts|april|maj|juni|juli|august|september|oktober|november|december))`),
+		"$1∯$2",
+	)
+)
@@ -0,0 +1,140 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Danish(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Simple period to end sentence",
+			args: args{
+				text: "Hej Verden. Mit navn er Jonas.",
+			},
+			want: []string{"Hej Verden.", "Mit navn er Jonas."},
+		},
+		{
+			name: "2) Question mark to end sentence",
+			args: args{
+				text: "Hvad er dit navn? Mit nav er Jonas.",
+			},
+			want: []string{"Hvad er dit navn?", "Mit nav er Jonas."},
+		},
+		{
+			name: "3) Exclamation point to end sentence",
+			args: args{
+				text: "Der er den! Jeg fandt den.",
+			},
+			want: []string{"Der er den!", "Jeg fandt den."},
+		},
+		{
+			name: "4) One letter upper case abbreviations",
+			args: args{
+				text: "Mit navn er Jonas E. Smith.",
+			},
+			want: []string{"Mit navn er Jonas E. Smith."},
+		},
+		{
+			name: "5) One letter lower case abbreviations",
+			args: args{
+				text: "Slå op på s. 55.",
+			},
+			want: []string{"Slå op på s. 55."},
+		},
+		{
+			name: "6) Two letter lower case abbreviations in the middle of a sentence",
+			args: args{
+				text: "Var Jane og co. til festen?",
+			},
+			want: []string{"Var Jane og co. til festen?"},
+		},
+		{
+			name: "7) Two letter upper case abbreviations at the end of a sentence",
+			args: args{
+				text: "De lukkede aftalen med Pitt, Briggs & Co. Det lukkede i går.",
+			},
+			want: []string{"De lukkede aftalen med Pitt, Briggs & Co.", "Det lukkede i går."},
+		},
+		{
+			name: "8) Two letter prepositive abbreviations",
+			args: args{
+				text: "De holdt Skt. Hans i byen.",
+			},
+			want: []string{"De holdt Skt. Hans i byen."},
+		},
+		{
+			name: "9) Multi-period abbreviations in the middle of a sentence",
+			args: args{
+				text: "Vi købte f.eks. æbler, pærer bl.a. til kagen. Det kostede ca. 50 kr.",
+			},
+			want: []string{"Vi købte f.eks. æbler, pærer bl.a. til kagen.", "Det kostede ca. 50 kr."},
+		},
+		{
+			name: "10) Abbreviation followed by a number",
+			args: args{
+				text: "Se jf. afsnit 3. Der står det.",
+			},
+			want: []string{"Se jf. afsnit 3.", "Der står det."},
+		},
+		{
+			name: "11) Ordinal number in a date",
+			args: args{
+				text: "Mødet er den 5. maj kl. 14. Kom til tiden.",
+			},
+			want: []string{"Mødet er den 5. maj kl. 14.", "Kom til tiden."},
+		},
+		{
+			name: "12) Ordinal number in a date with a capitalized month",
+			args: args{
+				text: "Den 24. December holder vi jul. Det er hyggeligt.",
+			},
+			want: []string{"Den 24. December holder vi jul.", "Det er hyggeligt."},
+		},
+		{
+			name: "13) Ordinal number followed by a lower case word",
+			args: args{
+				text: "Hun kom på en 3. plads. Det var flot.",
+			},
+			want: []string{"Hun kom på en 3. plads.", "Det var flot."},
+		},
+		{
+			name: "14) Number at the end of a sentence",
+			args: args{
+				text: "Vi har 5. Det er nok.",
+			},
+			want: []string{"Vi har 5.", "Det er nok."},
+		},
+		{
+			name: "15) Prepositive abbreviations of titles",
+			args: args{
+				text: "Han hedder hr. Jensen og bor på Østergade. Han er søn af fru. Jensen.",
+			},
+			want: []string{"Han hedder hr. Jensen og bor på Østergade.", "Han er søn af fru. Jensen."},
+		},
+		{
+			name: "16) Acronym followed by a lower case word",
+			args: args{
+				text: "Han har boet i U.S. i ti år. Han kan lide landet.",
+			},
+			want: []string{"Han har boet i U.S. i ti år.", "Han kan lide landet."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("da")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
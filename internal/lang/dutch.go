@@ -0,0 +1,50 @@
+package lang
+
+import (
+	"regexp"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/replacer"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+func newDutch() *processor.Config {
+	cfg := processor.Standard()
+	punctuationReplacer := replacer.NewPunctuationReplacer()
+	cfg.BetweenPunctuationReplacer = &betweenPunctuationReplacerDutch{
+		betweenPunctuation: replacer.NewBetweenPunctuation(punctuationReplacer),
+	}
+	cfg.Abbreviation.Abbreviations = []string{"a.d.h.v", "a.h.w", "a.s", "a.u.b", "aanv", "afb", "afd", "afk", "afz", "alg", "art", "b.v", "b.v.d", "bijv", "bijz", "blz", "bv", "ca", "d.d", "d.i", "d.m.v", "d.w.z", "dhr", "dr", "drs", "e.a", "e.d", "e.e.a", "e.o", "e.v", "enz", "etc", "evt", "excl", "fig", "fr", "i.c", "i.h.a", "i.o.m", "i.p.v", "i.s.m", "i.t.t", "i.v.m", "incl", "ing", "ir", "jl", "jr", "m.a.w", "m.b.t", "m.b.v", "m.i", "m.i.v", "max", "mevr", "min", "mr", "mw", "n.a.v", "n.b", "n.v.t", "nl", "nr", "o.a", "o.b.v", "o.i.d", "o.m", "o.v.v", "p", "p.p", "pag", "prof", "resp", "sr", "t.a.v", "t.b.v", "t.g.v", "t.o.v", "t.w", "t.z.t", "tel", "v.chr", "v.d", "v.l.n.r", "vgl", "vnl", "vs", "z.g.a.n", "z.s.m", "zg", "zgn"}
+	cfg.Abbreviation.PrePositiveAbbreviations = []string{"dhr", "dr", "drs", "ing", "ir", "mevr", "mr", "mw", "prof"}
+	cfg.Abbreviation.NumberAbbreviations = []string{"art", "blz", "fig", "nr", "p", "pag", "tel"}
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrLowerCase
+	cfg.QuotationAtEndOfSentenceRegex = quotationAtEndOfSentenceNlRegex
+	cfg.SplitSpaceQuotationAtEndOfSentenceRule = splitSpaceQuotationAtEndOfSentenceRuleNl
+	cfg.SentenceStarters = nil
+	return cfg
+}
+
+var (
+	// contractions with a leading apostrophe, e.g. "'s Avonds", "'t Is" or "'s-Hertogenbosch"
+	contractionNlRegex = regexp.MustCompile(`(^|[\s(“"])'([kmnst])([\s-])`)
+	// a sentence following a quotation may start with a contraction
+	quotationAtEndOfSentenceNlRegex          = regexp.MustCompile(`[!?.-]["'“”]\s(&⎋&[kmnst][\s-])?\p{Lu}`)
+	splitSpaceQuotationAtEndOfSentenceRuleNl = rule.NewRule(
+		regexp.MustCompile(`([!?.-]["'“”])\s((?:&⎋&[kmnst][\s-])?\p{Lu})`),
+		"$1\r$2",
+	)
+)
+
+type betweenPunctuationReplacerDutch struct {
+	betweenPunctuation replacer.BetweenPunctuation
+}
+
+// Replace hides the apostrophes of contractions before the punctuation between quotes is protected,
+// so that they aren't paired up as single quotes. They are put back by SubSingleQuoteRule.
+func (b *betweenPunctuationReplacerDutch) Replace(text string) string {
+	text = contractionNlRegex.ReplaceAllString(text, "$1&⎋&$2$3")
+	text = b.betweenPunctuation.Replace(text)
+	return text
+}
+
+var _ processor.BetweenPunctuationReplacer = (*betweenPunctuationReplacerDutch)(nil)
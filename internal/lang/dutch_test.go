@@ -0,0 +1,98 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Dutch(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Sentences starting with contractions",
+			args: args{
+				text: "'s Avonds gaan we uit. 't Is altijd gezellig.",
+			},
+			want: []string{"'s Avonds gaan we uit.", "'t Is altijd gezellig."},
+		},
+		{
+			name: "2) Contraction paired with a possessive apostrophe",
+			args: args{
+				text: "'s Morgens ging Hans' Broer werken. Hij was moe.",
+			},
+			want: []string{"'s Morgens ging Hans' Broer werken.", "Hij was moe."},
+		},
+		{
+			name: "3) Contraction inside a sentence paired with a possessive apostrophe",
+			args: args{
+				text: "Ik zag 't huis van Klaas' Vader. Het was groot.",
+			},
+			want: []string{"Ik zag 't huis van Klaas' Vader.", "Het was groot."},
+		},
+		{
+			name: "4) Contraction after a quotation",
+			args: args{
+				text: "Zij zei: 'Ik kom morgen.' 's Avonds kwam ze niet.",
+			},
+			want: []string{"Zij zei: 'Ik kom morgen.'", "'s Avonds kwam ze niet."},
+		},
+		{
+			name: "5) Contraction inside a quotation",
+			args: args{
+				text: "Hij zei: 'Kom 's kijken!' Niemand kwam.",
+			},
+			want: []string{"Hij zei: 'Kom 's kijken!'", "Niemand kwam."},
+		},
+		{
+			name: "6) Contraction in a place name",
+			args: args{
+				text: "Ik ga naar 's-Hertogenbosch. Daar woont mijn oom.",
+			},
+			want: []string{"Ik ga naar 's-Hertogenbosch.", "Daar woont mijn oom."},
+		},
+		{
+			name: "7) Abbreviations followed by lower case words",
+			args: args{
+				text: "Er zijn veel fruitsoorten, bijv. appels en peren. Zie blz. 5 voor meer.",
+			},
+			want: []string{"Er zijn veel fruitsoorten, bijv. appels en peren.", "Zie blz. 5 voor meer."},
+		},
+		{
+			name: "8) Titles and multi-period abbreviations",
+			args: args{
+				text: "Dhr. Jansen en Mevr. de Vries komen ook, d.w.z. als het niet regent. Dat is fijn.",
+			},
+			want: []string{"Dhr. Jansen en Mevr. de Vries komen ook, d.w.z. als het niet regent.", "Dat is fijn."},
+		},
+		{
+			name: "9) Abbreviation at the end of a sentence",
+			args: args{
+				text: "Hij kocht appels, peren enz. Daarna ging hij naar huis.",
+			},
+			want: []string{"Hij kocht appels, peren enz.", "Daarna ging hij naar huis."},
+		},
+		{
+			name: "10) Acronym followed by a lower case word",
+			args: args{
+				text: "Hij woont al tien jaar in de U.S. en werkt daar. Hij blijft er.",
+			},
+			want: []string{"Hij woont al tien jaar in de U.S. en werkt daar.", "Hij blijft er."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("nl")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
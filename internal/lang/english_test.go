@@ -1,6 +1,8 @@
 package lang_test
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/gosbd/gosbd"
@@ -469,3 +471,69 @@ func Test_English(t *testing.T) {
 		})
 	}
 }
+
+func Test_EnglishClean(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "Newline in the middle of a sentence",
+			args: args{
+				text: "It was a cold \nnight in the city.",
+			},
+			want: []string{"It was a cold night in the city."},
+		},
+		{
+			name: "Newline separated list",
+			args: args{
+				text: "features\ncontact manager\nevents, activities\n",
+			},
+			want: []string{"features", "contact manager", "events, activities"},
+		},
+		{
+			name: "HTML tags",
+			args: args{
+				text: "<h2 class=\"lined\">Hello</h2>\n<p>This is a test. Another test.</p>\n<div class=\"center\"><p>\n<img src=\"/images/content/example.jpg\">\n</p></div>",
+			},
+			want: []string{"Hello", "This is a test.", "Another test."},
+		},
+		{
+			name: "No space between sentences",
+			args: args{
+				text: "Hello world.Today is Tuesday.Mr. Smith went to the store and bought 1,000.That is a lot.",
+			},
+			want: []string{"Hello world.", "Today is Tuesday.", "Mr. Smith went to the store and bought 1,000.", "That is a lot."},
+		},
+		{
+			name: "Table of contents",
+			args: args{
+				text: "Introduction.......... 1\nChapter One.......... 5\nIt began on a Monday.",
+			},
+			want: []string{"Introduction", "Chapter One", "It began on a Monday."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("en", gosbd.Clean())
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Benchmark_English(b *testing.B) {
+	text := strings.Repeat("At 5 a.m. Mr. Smith went to the bank. He left the bank at 6 P.M. Mr. Smith then went to the store. "+
+		"Were Jane and co. at the party? I work for the U.S. Government in Virginia. Please turn to p. 55. ", 20)
+	sg := gosbd.NewSegmenter("en")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sg.Segment(text)
+	}
+}
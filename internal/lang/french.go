@@ -0,0 +1,37 @@
+package lang
+
+import (
+	"regexp"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+func newFrench() *processor.Config {
+	cfg := processor.Standard()
+	cfg.Abbreviation.Abbreviations = []string{"a.c.n", "a.m", "al", "ann", "apr", "art", "auj", "av", "b.p", "boul", "bd", "c.-à-d", "c.n", "c.n.s", "c.p.i", "c.q.f.d", "c.s", "ca", "cf", "ch.-l", "chap", "co", "contr", "dir", "dr", "e.g", "e.v", "env", "etc", "ex", "fasc", "fig", "fr", "fém", "hab", "i.e", "ibid", "id", "inf", "j", "j.-c", "l.d", "lib", "ll.aa", "ll.aa.ii", "ll.aa.rr", "ll.aa.ss", "ll.ee", "ll.mm", "ll.mm.ii.rr", "loc.cit", "ltd", "masc", "me", "mgr", "mlle", "mlles", "mm", "mme", "mmes", "ms", "n.b", "n.d", "n.d.a", "n.d.l.r", "n.d.t", "n.p.a.i", "n.s", "n/réf", "nn.ss", "p", "p.c.c", "p.ex", "p.j", "p.s", "pl", "pp", "pr", "r.-v", "r.a.s", "r.i.p", "r.p", "s.a", "s.a.i", "s.a.r", "s.a.s", "s.e", "s.m", "s.m.i.r", "s.s", "sec", "sect", "sing", "sq", "sqq", "ss", "st", "ste", "suiv", "sup", "suppl", "t.s.v.p", "tél", "vb", "vol", "vs", "x.o", "z.i", "éd"}
+	// these are always followed by a name, a date or an address
+	cfg.Abbreviation.PrePositiveAbbreviations = []string{"apr", "av", "bd", "boul", "dr", "me", "mgr", "mlle", "mlles", "mm", "mme", "mmes", "pr", "st", "ste"}
+	cfg.Abbreviation.NumberAbbreviations = []string{"art", "chap", "fig", "p", "pp", "vol"}
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrLowerCase
+	// French puts a space, often a non-breaking one, before "!" and "?".
+	// A word in lower case following them continues the sentence, e.g. "« Tu viens ? » demanda-t-il".
+	cfg.ExclamationPointRules.All = append(cfg.ExclamationPointRules.All,
+		exclamationPointBeforeCommaMidSentenceRule,
+		exclamationPointMidSentenceRule,
+		questionMarkMidSentenceRule,
+	)
+	cfg.QuotationAtEndOfSentenceRegex = quotationAtEndOfSentenceFrRegex
+	cfg.SplitSpaceQuotationAtEndOfSentenceRule = splitSpaceQuotationAtEndOfSentenceRuleFr
+	cfg.SentenceStarters = nil
+	return cfg
+}
+
+var (
+	// guillemets usually have spaces inside, e.g. "« Je viens. » Puis il partit."
+	quotationAtEndOfSentenceFrRegex          = regexp.MustCompile(`[!?.…-](["'“”]|[\s\x{a0}\x{202f}]?»)\s\p{Lu}`)
+	splitSpaceQuotationAtEndOfSentenceRuleFr = rule.NewRule(
+		regexp.MustCompile(`([!?.…-](?:["'“”]|[\s\x{a0}\x{202f}]?»))\s(\p{Lu})`),
+		"$1\r$2",
+	)
+)
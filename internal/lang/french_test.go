@@ -0,0 +1,147 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_French(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Simple period to end sentence",
+			args: args{
+				text: "Après avoir été l'un des acteurs du projet génome humain, le Genoscope met aujourd'hui le cap vers la génomique environnementale. L'exploitation des données de séquences, prolongée par l'identification expérimentale des fonctions biologiques, notamment dans le domaine de la biocatalyse, ouvrent des perspectives de développements en biotechnologie industrielle.",
+			},
+			want: []string{
+				"Après avoir été l'un des acteurs du projet génome humain, le Genoscope met aujourd'hui le cap vers la génomique environnementale.",
+				"L'exploitation des données de séquences, prolongée par l'identification expérimentale des fonctions biologiques, notamment dans le domaine de la biocatalyse, ouvrent des perspectives de développements en biotechnologie industrielle.",
+			},
+		},
+		{
+			name: "2) Quotation followed by an incise",
+			args: args{
+				text: "\"Airbus livrera comme prévu 30 appareils 380 cette année avec en ligne de mire l'objectif d'équilibre financier du programme en 2015\", a-t-il ajouté.",
+			},
+			want: []string{
+				"\"Airbus livrera comme prévu 30 appareils 380 cette année avec en ligne de mire l'objectif d'équilibre financier du programme en 2015\", a-t-il ajouté.",
+			},
+		},
+		{
+			name: "3) Space before a colon",
+			args: args{
+				text: "À 11 heures ce matin, la direction ne décomptait que douze grévistes en tout sur la France : ce sont ceux du site de Saran (Loiret), dont l’effectif est de 809 salariés, dont la direction précise qu’elle a toujours refusé la session de quatre jours ouvrés au Mans.",
+			},
+			want: []string{
+				"À 11 heures ce matin, la direction ne décomptait que douze grévistes en tout sur la France : ce sont ceux du site de Saran (Loiret), dont l’effectif est de 809 salariés, dont la direction précise qu’elle a toujours refusé la session de quatre jours ouvrés au Mans.",
+			},
+		},
+		{
+			name: "4) Space before a question mark",
+			args: args{
+				text: "Comment allez-vous ? Très bien, merci.",
+			},
+			want: []string{"Comment allez-vous ?", "Très bien, merci."},
+		},
+		{
+			name: "5) Non-breaking space before an exclamation point",
+			args: args{
+				text: "Attention ! Il arrive.",
+			},
+			want: []string{"Attention !", "Il arrive."},
+		},
+		{
+			name: "6) Space before a semicolon",
+			args: args{
+				text: "Il y avait trois choses ; la première était simple. La seconde non.",
+			},
+			want: []string{"Il y avait trois choses ; la première était simple.", "La seconde non."},
+		},
+		{
+			name: "7) Incise after a question mark",
+			args: args{
+				text: "Tu viens ? demanda-t-il. Oui.",
+			},
+			want: []string{"Tu viens ? demanda-t-il.", "Oui."},
+		},
+		{
+			name: "8) Incise after an exclamation point",
+			args: args{
+				text: "Quelle joie ! s'écria-t-elle. Elle riait.",
+			},
+			want: []string{"Quelle joie ! s'écria-t-elle.", "Elle riait."},
+		},
+		{
+			name: "9) Guillemets with inner spaces",
+			args: args{
+				text: "Il a dit : « Je viens. » Puis il est parti.",
+			},
+			want: []string{"Il a dit : « Je viens. »", "Puis il est parti."},
+		},
+		{
+			name: "10) Guillemets followed by an incise",
+			args: args{
+				text: "« Viens ici ! » dit-elle. Il vint.",
+			},
+			want: []string{"« Viens ici ! » dit-elle.", "Il vint."},
+		},
+		{
+			name: "11) Era abbreviations",
+			args: args{
+				text: "Jules César est mort en 44 av. J.-C. et Auguste en 14 apr. J.-C. Ils étaient romains.",
+			},
+			want: []string{"Jules César est mort en 44 av. J.-C. et Auguste en 14 apr. J.-C.", "Ils étaient romains."},
+		},
+		{
+			name: "12) Titles and addresses",
+			args: args{
+				text: "M. Dupont et Mme. Martin habitent au 12 av. de la République. Ils sont voisins.",
+			},
+			want: []string{"M. Dupont et Mme. Martin habitent au 12 av. de la République.", "Ils sont voisins."},
+		},
+		{
+			name: "13) Abbreviation followed by a lower case letter with a diacritic",
+			args: args{
+				text: "Il a acheté des pommes, des poires, etc. à Paris. Puis il est rentré.",
+			},
+			want: []string{"Il a acheté des pommes, des poires, etc. à Paris.", "Puis il est rentré."},
+		},
+		{
+			name: "14) Abbreviation at the end of a sentence",
+			args: args{
+				text: "Il a acheté des pommes, des poires, etc. Puis il est rentré.",
+			},
+			want: []string{"Il a acheté des pommes, des poires, etc.", "Puis il est rentré."},
+		},
+		{
+			name: "15) Number abbreviations",
+			args: args{
+				text: "Voir p. 5 et fig. 3. Le chap. 2 est long.",
+			},
+			want: []string{"Voir p. 5 et fig. 3.", "Le chap. 2 est long."},
+		},
+		{
+			name: "16) Acronym followed by a lower case word",
+			args: args{
+				text: "Il vit aux U.S. depuis dix ans. Il aime ce pays.",
+			},
+			want: []string{"Il vit aux U.S. depuis dix ans.", "Il aime ce pays."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("fr")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
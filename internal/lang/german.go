@@ -0,0 +1,106 @@
+package lang
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/replacer"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+func newGerman() *processor.Config {
+	cfg := processor.Standard()
+	punctuationReplacer := replacer.NewPunctuationReplacer()
+	cfg.BetweenPunctuationReplacer = &betweenPunctuationReplacerGerman{
+		punctuationReplacer: punctuationReplacer,
+		betweenPunctuation:  replacer.NewBetweenPunctuation(punctuationReplacer),
+	}
+	cfg.Abbreviation.Abbreviations = []string{"ä", "adj", "adm", "adv", "art", "asst", "b.a", "b.s", "bart", "bldg", "brig", "bros", "bse", "buchst", "bzgl", "bzw", "c.-à-d", "ca", "capt", "chr", "cmdr", "co", "col", "comdr", "con", "corp", "cpl", "d.h", "d.j", "dergl", "dgl", "dkr", "dr", "ens", "etc", "ev", "evtl", "ff", "g.g.a", "g.u", "gen", "ggf", "gov", "hon", "hosp", "i.f", "i.h.v", "ii", "iii", "insp", "iv", "ix", "jun", "k.o", "kath", "lfd", "lt", "ltd", "m.e", "maj", "med", "messrs", "mio", "mlle", "mm", "mme", "mr", "mrd", "mrs", "ms", "msgr", "mwst", "no", "nos", "nr", "o.ä", "op", "ord", "pfc", "ph", "pp", "prof", "pvt", "rep", "reps", "res", "rev", "rt", "s.p.a", "sa", "sen", "sens", "sfc", "sgt", "sog", "sogen", "spp", "sr", "st", "std", "str", "supt", "surg", "u.a", "u.e", "u.s.w", "u.u", "u.ä", "univ", "usf", "usw", "v", "vgl", "vi", "vii", "viii", "vs", "x", "xi", "xii", "xiii", "xiv", "xix", "xv", "xvi", "xvii", "xviii", "xx", "z.b", "z.t", "z.z", "z.zt", "zt", "zzt", "univ.-prof", "o.univ.-prof", "ao.univ.prof", "ass.prof", "hon.prof", "univ.-doz", "univ.ass", "stud.ass", "projektass", "ass", "di", "dipl.-ing", "mag"}
+	cfg.Abbreviation.PrePositiveAbbreviations = nil
+	cfg.Abbreviation.NumberAbbreviations = []string{"art", "ca", "no", "nos", "nr", "pp"}
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrDe
+	cfg.Abbreviation.SingleLetterAbbreviationRules = append(rule.Rules{
+		singleLowerCaseLetterRuleDe,
+		singleLowerCaseLetterAtStartOfLineRuleDe,
+	}, cfg.Abbreviation.SingleLetterAbbreviationRules...)
+	cfg.Numbers.OrdinalRules = rule.Rules{
+		numberPeriodSpaceRuleDe,
+		negativeNumberPeriodSpaceRuleDe,
+		periodInDateRuleDe,
+		numberBeforeSentenceStarterRuleDe,
+	}
+	cfg.QuotationAtEndOfSentenceRegex = quotationAtEndOfSentenceDeRegex
+	cfg.SplitSpaceQuotationAtEndOfSentenceRule = splitSpaceQuotationAtEndOfSentenceRuleDe
+	cfg.SentenceStarters = sentenceStartersDe
+	return cfg
+}
+
+// replacePeriodOfAbbrDe keeps the period following an abbreviation if a whitespace
+// or a hyphen, as in "Univ.-Prof.", follows it.
+// Nouns are capitalized in German, so a capital letter doesn't start a new sentence,
+// only the sentence starters do.
+func replacePeriodOfAbbrDe(abbr, next string) bool {
+	return periodOfAbbrNextDeRegex.MatchString(next)
+}
+
+var sentenceStartersDe = strings.Split(
+	"Aber Als Am Auch Auf Bei Da Danach Dann Das Deshalb Der Die Dort Ein Eine Er Es Für Heute Ich Im In Ist Jetzt Mein Mit Nach Sie So Trotzdem Und Warum Was Wenn Wer Wie Wir",
+	" ",
+)
+
+var (
+	periodOfAbbrNextDeRegex = regexp.MustCompile(`\A[\s-]`)
+	// Rubular: http://rubular.com/r/B4X33QKIL8
+	// SingleLowerCaseLetterRule = Rule(r'(?<=\s[a-z])\.(?=\s)', '∯')
+	singleLowerCaseLetterRuleDe = rule.NewRule(regexp.MustCompile(`(\s[a-z])\.(\s)`), "$1∯$2")
+	// Rubular: http://rubular.com/r/iUNSkCuso0
+	// SingleLowerCaseLetterAtStartOfLineRule = Rule(r'(?<=^[a-z])\.(?=\s)', '∯')
+	singleLowerCaseLetterAtStartOfLineRuleDe = rule.NewRule(regexp.MustCompile(`(^[a-z])\.(\s)`), "$1∯$2")
+	// Rubular: http://rubular.com/r/hZxoyQwKT1
+	// NumberPeriodSpaceRule = Rule(r'(?<=\s[0-9]|\s([1-9][0-9]))\.(?=\s)', '∯')
+	numberPeriodSpaceRuleDe = rule.NewRule(regexp.MustCompile(`(\s(?:[0-9]|[1-9][0-9]))\.(\s)`), "$1∯$2")
+	// Rubular: http://rubular.com/r/ityNMwdghj
+	// NegativeNumberPeriodSpaceRule = Rule(r'(?<=-[0-9]|-([1-9][0-9]))\.(?=\s)', '∯')
+	negativeNumberPeriodSpaceRuleDe = rule.NewRule(regexp.MustCompile(`(-(?:[0-9]|[1-9][0-9]))\.(\s)`), "$1∯$2")
+	// Rubular: http://rubular.com/r/zlqgj7G5dA
+	periodInDateRuleDe = rule.NewRule(
+		regexp.MustCompile(`(\d)\.(\s*(?:Januar|Jänner|Februar|März|April|Mai|Juni|Juli|August|September|Oktober|November|Dezember))`),
+		"$1∯$2",
+	)
+	// a number ending a sentence, e.g. "Ich bin 25. Das ist jung.",
+	// is told from an ordinal number by the sentence starter following it
+	numberBeforeSentenceStarterRuleDe = rule.NewRule(
+		regexp.MustCompile(`([\s-](?:[0-9]|[1-9][0-9]))∯(\s(?:`+quoteWords(sentenceStartersDe)+`)\s)`),
+		"$1.$2",
+	)
+)
+
+var (
+	// Rubular: http://rubular.com/r/TkZomF9tTM
+	betweenDoubleQuotesDeRegex = regexp.MustCompile(`„([^“\\]+|\\{2}|\\.)*“`)
+	// Rubular: http://rubular.com/r/OdcXBsub0w
+	betweenUnconventionalDoubleQuotesDeRegex = regexp.MustCompile(",,([^`\\\\]+|\\\\{2}|\\\\.)*``")
+	// the quotation marks also include the German ones, and a sentence may start with any capital letter
+	quotationAtEndOfSentenceDeRegex          = regexp.MustCompile("[!?.-](?:[\"'“”]|``)\\s(?:\\p{Lu}|„|,,)")
+	splitSpaceQuotationAtEndOfSentenceRuleDe = rule.NewRule(
+		regexp.MustCompile("([!?.-](?:[\"'“”]|``))\\s(\\p{Lu}|„|,,)"),
+		"$1\r$2",
+	)
+)
+
+type betweenPunctuationReplacerGerman struct {
+	punctuationReplacer replacer.Punctuation
+	betweenPunctuation  replacer.BetweenPunctuation
+}
+
+func (b *betweenPunctuationReplacerGerman) Replace(text string) string {
+	text = betweenDoubleQuotesDeRegex.ReplaceAllStringFunc(
+		text, b.punctuationReplacer.ReplaceFunc(processor.PunctuationMatchTypeNone))
+	text = betweenUnconventionalDoubleQuotesDeRegex.ReplaceAllStringFunc(
+		text, b.punctuationReplacer.ReplaceFunc(processor.PunctuationMatchTypeNone))
+	text = b.betweenPunctuation.Replace(text)
+	return text
+}
+
+var _ processor.BetweenPunctuationReplacer = (*betweenPunctuationReplacerGerman)(nil)
@@ -0,0 +1,159 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_German(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Quotation at end of sentence",
+			args: args{
+				text: "„Ich habe heute keine Zeit“, sagte die Frau und flüsterte leise: „Und auch keine Lust.“ Wir haben 1.000.000 Euro.",
+			},
+			want: []string{
+				"„Ich habe heute keine Zeit“, sagte die Frau und flüsterte leise: „Und auch keine Lust.“",
+				"Wir haben 1.000.000 Euro.",
+			},
+		},
+		{
+			name: "2) Unconventional double quotes",
+			args: args{
+				text: "Thomas sagte: ,,Wann kommst zu mir?`` ,,Das weiß ich noch nicht``, antwortete Susi, ,,wahrscheinlich am Sonntag.`` Wir haben 1.000.000 Euro.",
+			},
+			want: []string{
+				"Thomas sagte: ,,Wann kommst zu mir?``",
+				",,Das weiß ich noch nicht``, antwortete Susi, ,,wahrscheinlich am Sonntag.``",
+				"Wir haben 1.000.000 Euro.",
+			},
+		},
+		{
+			name: "3) Abbreviation with spaces",
+			args: args{
+				text: "Es gibt jedoch einige Vorsichtsmaßnahmen, die Du ergreifen kannst, z. B. ist es sehr empfehlenswert, dass Du Dein Zuhause von allem Junkfood befreist.",
+			},
+			want: []string{
+				"Es gibt jedoch einige Vorsichtsmaßnahmen, die Du ergreifen kannst, z. B. ist es sehr empfehlenswert, dass Du Dein Zuhause von allem Junkfood befreist.",
+			},
+		},
+		{
+			name: "4) Abbreviation followed by a noun",
+			args: args{
+				text: "Es gab ein Gespräch zwischen z.B. Peter und Anna. Danach gingen sie nach Hause.",
+			},
+			want: []string{
+				"Es gab ein Gespräch zwischen z.B. Peter und Anna.",
+				"Danach gingen sie nach Hause.",
+			},
+		},
+		{
+			name: "5) Ordinal number before a month",
+			args: args{
+				text: "Was sind die Konsequenzen der Abstimmung vom 12. Juni?",
+			},
+			want: []string{"Was sind die Konsequenzen der Abstimmung vom 12. Juni?"},
+		},
+		{
+			name: "6) Ordinal number in a date",
+			args: args{
+				text: "Das Treffen findet am 3. Oktober statt. Bitte sei pünktlich.",
+			},
+			want: []string{"Das Treffen findet am 3. Oktober statt.", "Bitte sei pünktlich."},
+		},
+		{
+			name: "7) Ordinal number without a month",
+			args: args{
+				text: "Sie wurde beim 2. Versuch Erste. Das war knapp.",
+			},
+			want: []string{"Sie wurde beim 2. Versuch Erste.", "Das war knapp."},
+		},
+		{
+			name: "8) Lower case abbreviations",
+			args: args{
+				text: "Wir haben Äpfel, Birnen usw. gekauft. Danach gingen wir nach Hause.",
+			},
+			want: []string{"Wir haben Äpfel, Birnen usw. gekauft.", "Danach gingen wir nach Hause."},
+		},
+		{
+			name: "9) Number abbreviations",
+			args: args{
+				text: "Bitte lies Nr. 5 bzw. die Anlage. Die Stadt hat ca. 5.000 Einwohner.",
+			},
+			want: []string{"Bitte lies Nr. 5 bzw. die Anlage.", "Die Stadt hat ca. 5.000 Einwohner."},
+		},
+		{
+			name: "10) Abbreviation at end of text",
+			args: args{
+				text: "Wir brauchen Stifte, Papier usw.",
+			},
+			want: []string{"Wir brauchen Stifte, Papier usw."},
+		},
+		{
+			name: "11) Question in quotation",
+			args: args{
+				text: "Er fragte: „Kommst du morgen?“ Sie antwortete nicht.",
+			},
+			want: []string{"Er fragte: „Kommst du morgen?“", "Sie antwortete nicht."},
+		},
+		{
+			name: "12) Kommanditgesellschaft",
+			args: args{
+				text: "Die Firma Müller & Co. KG wurde 1950 gegründet. Sie hat 20 Mitarbeiter.",
+			},
+			want: []string{"Die Firma Müller & Co. KG wurde 1950 gegründet.", "Sie hat 20 Mitarbeiter."},
+		},
+		{
+			name: "13) Academic titles",
+			args: args{
+				text: "Die Vorlesung hält Univ.-Prof. Dr. Müller. Sie beginnt um 10 Uhr.",
+			},
+			want: []string{"Die Vorlesung hält Univ.-Prof. Dr. Müller.", "Sie beginnt um 10 Uhr."},
+		},
+		{
+			name: "14) Exclamation and question marks",
+			args: args{
+				text: "Das ist ja toll! Wie hast du das gemacht? Erzähl mal.",
+			},
+			want: []string{"Das ist ja toll!", "Wie hast du das gemacht?", "Erzähl mal."},
+		},
+		{
+			name: "15) Abbreviation at end of sentence",
+			args: args{
+				text: "Wir kaufen Äpfel, Birnen usw. Danach gehen wir heim. Er mag Musik, Kunst etc. Sie mag Sport.",
+			},
+			want: []string{"Wir kaufen Äpfel, Birnen usw.", "Danach gehen wir heim.", "Er mag Musik, Kunst etc.", "Sie mag Sport."},
+		},
+		{
+			name: "16) Number at end of sentence",
+			args: args{
+				text: "Ich bin 25. Das ist jung. Er kam am 3. Das war gut.",
+			},
+			want: []string{"Ich bin 25.", "Das ist jung.", "Er kam am 3.", "Das war gut."},
+		},
+		{
+			name: "17) Ordinal number before a capitalized word",
+			args: args{
+				text: "Sie kam als 1. Läuferin ins Ziel. Er wurde 3. im Rennen.",
+			},
+			want: []string{"Sie kam als 1. Läuferin ins Ziel.", "Er wurde 3. im Rennen."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("de")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package lang
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/replacer"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+func newGreek() *processor.Config {
+	cfg := processor.Standard()
+	punctuationReplacer := replacer.NewPunctuationReplacer()
+	cfg.BetweenPunctuationReplacer = &betweenPunctuationReplacerGreek{
+		betweenPunctuation: replacer.NewBetweenPunctuation(punctuationReplacer),
+	}
+	cfg.Abbreviation.Abbreviations = []string{"αγ", "αι", "αρ", "βλ", "γεν", "δηλ", "δρ", "εκ", "εκατ", "ελ", "κ", "κ.ά", "κ.λπ", "κ.τ.λ", "κα", "καθ", "κκ", "κτλ", "λ.χ", "λεωφ", "μ.μ", "μ.χ", "ό.π", "οδ", "π", "π.μ", "π.χ", "πβ", "πρβλ", "σ", "σελ", "σημ", "στρ", "τ.μ", "τηλ", "τόμ", "υπ", "χιλ", "χλμ"}
+	// these are always followed by a name, e.g. "ο κ. Παπαδόπουλος" or "οδ. Ερμού"
+	cfg.Abbreviation.PrePositiveAbbreviations = []string{"αγ", "δρ", "κ", "κα", "καθ", "κκ", "λεωφ", "οδ"}
+	cfg.Abbreviation.NumberAbbreviations = []string{"αρ", "σ", "σελ", "τηλ", "τόμ"}
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrLowerCase
+	// the Greek question mark U+037E is usually typed as a semicolon,
+	// while the ano teleia "·" used as a semicolon doesn't end a sentence
+	addSentenceTerminators(cfg, ";", "\u037e")
+	cfg.SubSymbolsRules.All = append(cfg.SubSymbolsRules.All, semicolonElRule, greekQuestionMarkElRule)
+	cfg.QuotationAtEndOfSentenceRegex = quotationAtEndOfSentenceElRegex
+	cfg.SplitSpaceQuotationAtEndOfSentenceRule = splitSpaceQuotationAtEndOfSentenceRuleEl
+	cfg.SentenceStarters = nil
+	return cfg
+}
+
+var (
+	// a semicolon is a question mark only if it follows a Greek word, possibly closed by quotes or parens
+	semicolonElRegex = regexp.MustCompile(`(\p{Greek}[»"”’')\]]*)?;`)
+	// abbreviations written with periods, e.g. "π.χ." or "κ.τ.λ."
+	multiPeriodAbbreviationElRegex = regexp.MustCompile(`(?:^|[\s(«"“])\p{Greek}{1,3}(?:\.\p{Greek}{1,3})+[.∯]`)
+	// punctuation between quotes or parens doesn't end a sentence, e.g. "«Πού πας;» ρώτησε"
+	betweenPunctuationElRegex = regexp.MustCompile(`«[^»]*»|“[^”]*”|"[^"]*"|\([^()]*\)`)
+	semicolonPlaceholderEl    = strings.NewReplacer(";", "&ᓶ&", "\u037e", "&ᓵ&")
+	semicolonElRule           = rule.NewRule(regexp.MustCompile(`&ᓶ&`), ";")
+	greekQuestionMarkElRule   = rule.NewRule(regexp.MustCompile(`&ᓵ&`), "\u037e")
+
+	quotationAtEndOfSentenceElRegex          = regexp.MustCompile(`(?:[!?.…-]|\p{Greek}[;\x{37e}])(?:["'“”]|»)\s\p{Lu}`)
+	splitSpaceQuotationAtEndOfSentenceRuleEl = rule.NewRule(
+		regexp.MustCompile(`((?:[!?.…-]|\p{Greek}[;\x{37e}])(?:["'“”]|»))\s(\p{Lu})`),
+		"$1\r$2",
+	)
+)
+
+type betweenPunctuationReplacerGreek struct {
+	betweenPunctuation replacer.BetweenPunctuation
+}
+
+// Replace hides the question marks between quotes or parens and the semicolons of Latin-script text,
+// so that they don't end a sentence. They are put back by the sub symbols rules.
+// The periods inside abbreviations are also replaced.
+func (b *betweenPunctuationReplacerGreek) Replace(text string) string {
+	text = replacePeriodsInsideAbbreviations(multiPeriodAbbreviationElRegex, text)
+	text = betweenPunctuationElRegex.ReplaceAllStringFunc(text, semicolonPlaceholderEl.Replace)
+	text = semicolonElRegex.ReplaceAllStringFunc(text, func(match string) string {
+		if match == ";" {
+			return "&ᓶ&"
+		}
+		return match
+	})
+	text = b.betweenPunctuation.Replace(text)
+	return text
+}
+
+var _ processor.BetweenPunctuationReplacer = (*betweenPunctuationReplacerGreek)(nil)
@@ -0,0 +1,133 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Greek(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Simple period to end sentence",
+			args: args{
+				text: "Γεια σου. Είμαι ο Γιάννης.",
+			},
+			want: []string{"Γεια σου.", "Είμαι ο Γιάννης."},
+		},
+		{
+			name: "2) Greek question mark typed as a semicolon",
+			args: args{
+				text: "Πού πας; Στο σπίτι.",
+			},
+			want: []string{"Πού πας;", "Στο σπίτι."},
+		},
+		{
+			name: "3) Greek question mark",
+			args: args{
+				text: "Τι ώρα είναι\u037e Είναι αργά.",
+			},
+			want: []string{"Τι ώρα είναι\u037e", "Είναι αργά."},
+		},
+		{
+			name: "4) Consecutive questions",
+			args: args{
+				text: "Ξέρεις τι έγινε; Όχι; Θα σου πω!",
+			},
+			want: []string{"Ξέρεις τι έγινε;", "Όχι;", "Θα σου πω!"},
+		},
+		{
+			name: "5) Semicolons in Latin-script text",
+			args: args{
+				text: "The program uses a; b; c as separators. Είναι απλό.",
+			},
+			want: []string{"The program uses a; b; c as separators.", "Είναι απλό."},
+		},
+		{
+			name: "6) Semicolon in a Latin-script quotation",
+			args: args{
+				text: "Είπε: \"Hello; world.\" Μετά έφυγε.",
+			},
+			want: []string{"Είπε: \"Hello; world.\"", "Μετά έφυγε."},
+		},
+		{
+			name: "7) Question inside guillemets in the middle of a sentence",
+			args: args{
+				text: "«Πού πας;» ρώτησε η Μαρία. Δεν απάντησα.",
+			},
+			want: []string{"«Πού πας;» ρώτησε η Μαρία.", "Δεν απάντησα."},
+		},
+		{
+			name: "8) Question inside guillemets at the end of a sentence",
+			args: args{
+				text: "«Πού πας;» Η Μαρία δεν περίμενε απάντηση.",
+			},
+			want: []string{"«Πού πας;»", "Η Μαρία δεν περίμενε απάντηση."},
+		},
+		{
+			name: "9) Ano teleia doesn't end a sentence",
+			args: args{
+				text: "Ήρθαν όλοι· ο Γιάννης και η Μαρία. Ήταν ωραία.",
+			},
+			want: []string{"Ήρθαν όλοι· ο Γιάννης και η Μαρία.", "Ήταν ωραία."},
+		},
+		{
+			name: "10) Prepositive abbreviation",
+			args: args{
+				text: "Ήρθε ο κ. Παπαδόπουλος. Ήταν αργά.",
+			},
+			want: []string{"Ήρθε ο κ. Παπαδόπουλος.", "Ήταν αργά."},
+		},
+		{
+			name: "11) Multi-period abbreviation in the middle of a sentence",
+			args: args{
+				text: "Φέραμε φρούτα, π.χ. μήλα και πορτοκάλια. Ήταν νόστιμα.",
+			},
+			want: []string{"Φέραμε φρούτα, π.χ. μήλα και πορτοκάλια.", "Ήταν νόστιμα."},
+		},
+		{
+			name: "12) Multi-period abbreviation at the end of a sentence",
+			args: args{
+				text: "Έφερε βιβλία, τετράδια κ.τ.λ. Ήταν έτοιμος.",
+			},
+			want: []string{"Έφερε βιβλία, τετράδια κ.τ.λ.", "Ήταν έτοιμος."},
+		},
+		{
+			name: "13) Multi-period abbreviation with an upper case letter",
+			args: args{
+				text: "Το 300 π.Χ. ήταν μια άλλη εποχή. Τώρα ζούμε αλλιώς.",
+			},
+			want: []string{"Το 300 π.Χ. ήταν μια άλλη εποχή.", "Τώρα ζούμε αλλιώς."},
+		},
+		{
+			name: "14) Abbreviation followed by a number",
+			args: args{
+				text: "Διάβασε τη σελ. 5 του βιβλίου. Είναι ενδιαφέρουσα.",
+			},
+			want: []string{"Διάβασε τη σελ. 5 του βιβλίου.", "Είναι ενδιαφέρουσα."},
+		},
+		{
+			name: "15) Acronym followed by a lower case word",
+			args: args{
+				text: "Ζει στις U.S. εδώ και δέκα χρόνια. Του αρέσει η χώρα.",
+			},
+			want: []string{"Ζει στις U.S. εδώ και δέκα χρόνια.", "Του αρέσει η χώρα."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("el")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package lang
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+func newHindi() *processor.Config {
+	cfg := processor.Standard()
+	cfg.BetweenPunctuationReplacer = newBetweenPunctuationReplacerDevanagari()
+	cfg.Abbreviation.Abbreviations = []string{"ई", "ई.पू", "कि.ग्रा", "कि.मी", "डॉ", "पं", "पृ", "प्रो", "मि", "मी", "श्री", "श्रीमती", "सं", "से.मी"}
+	cfg.Abbreviation.PrePositiveAbbreviations = []string{"डॉ", "पं", "प्रो", "श्री", "श्रीमती"}
+	cfg.Abbreviation.NumberAbbreviations = []string{"पृ"}
+	// Hindi and Marathi put the verb at the end of a sentence, so an abbreviation rarely ends one
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrAlways
+	cfg.Numbers = numbersWithDigits("०-९")
+	// as in pySBD, a vertical bar is often typed for a danda
+	addSentenceTerminators(cfg, "।", "॥", "|")
+	cfg.NonSentenceBoundaryRules = rule.Rules{verticalBarNotDandaRule}
+	cfg.SubSymbolsRules.All = append(cfg.SubSymbolsRules.All, dandaRule, doubleDandaRule, verticalBarRule)
+	cfg.SentenceStarters = nil
+	return cfg
+}
+
+var (
+	// abbreviations written with periods, e.g. "ई.पू." or "कि.मी."
+	multiPeriodAbbreviationDevanagariRegex = regexp.MustCompile(`(?:^|[\s(“"])\p{Devanagari}{1,4}(?:\.\p{Devanagari}{1,4})+[.∯]`)
+	betweenPunctuationDevanagariRegex      = regexp.MustCompile(`“[^“”]*”|"[^"]*"|\([^()]*\)`)
+)
+
+// The dandas are replaced with placeholders when they don't end a sentence.
+var (
+	dandaPlaceholder = strings.NewReplacer("।", "&ᓻ&", "॥", "&ᓼ&")
+	dandaRule        = rule.NewRule(regexp.MustCompile(`&ᓻ&`), "।")
+	doubleDandaRule  = rule.NewRule(regexp.MustCompile(`&ᓼ&`), "॥")
+)
+
+var (
+	// a vertical bar is a danda only after Devanagari text or a whitespace, e.g. not in "5|6"
+	verticalBarNotDandaRule = rule.NewRule(regexp.MustCompile(`([^\p{Devanagari}\s])\|`), "$1&ᔂ&")
+	verticalBarRule         = rule.NewRule(regexp.MustCompile(`&ᔂ&`), "|")
+)
+
+// newBetweenPunctuationReplacerDevanagari returns the replacer shared by the languages written in the Devanagari script.
+func newBetweenPunctuationReplacerDevanagari() processor.BetweenPunctuationReplacer {
+	return newBetweenPunctuationReplacerScript(betweenPunctuationDevanagariRegex, dandaPlaceholder, multiPeriodAbbreviationDevanagariRegex)
+}
@@ -0,0 +1,98 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Hindi(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Danda",
+			args: args{
+				text: "सच्चाई यह है कि इसे कोई नहीं जानता। हो सकता है यह फ़्रेन्को के खिलाफ़ कोई विद्रोह रहा हो, या फिर बेकाबू हो गया कोई आनंदोत्सव।",
+			},
+			want: []string{"सच्चाई यह है कि इसे कोई नहीं जानता।", "हो सकता है यह फ़्रेन्को के खिलाफ़ कोई विद्रोह रहा हो, या फिर बेकाबू हो गया कोई आनंदोत्सव।"},
+		},
+		{
+			name: "2) Question mark and exclamation point",
+			args: args{
+				text: "क्या तुम आओगे? हाँ, मैं आऊँगा! ठीक है।",
+			},
+			want: []string{"क्या तुम आओगे?", "हाँ, मैं आऊँगा!", "ठीक है।"},
+		},
+		{
+			name: "3) Double danda",
+			args: args{
+				text: "धर्मो रक्षति रक्षितः॥ यह श्लोक है।",
+			},
+			want: []string{"धर्मो रक्षति रक्षितः॥", "यह श्लोक है।"},
+		},
+		{
+			name: "4) Vertical bar typed for a danda",
+			args: args{
+				text: "राम वन गए| सीता साथ गईं|",
+			},
+			want: []string{"राम वन गए|", "सीता साथ गईं|"},
+		},
+		{
+			name: "5) Vertical bar between numbers",
+			args: args{
+				text: "कीमत 5|6 रुपये है। वह सस्ता है।",
+			},
+			want: []string{"कीमत 5|6 रुपये है।", "वह सस्ता है।"},
+		},
+		{
+			name: "6) Danda inside quotes",
+			args: args{
+				text: "उसने कहा, “मैं आऊँगा।” फिर वह चला गया।",
+			},
+			want: []string{"उसने कहा, “मैं आऊँगा।” फिर वह चला गया।"},
+		},
+		{
+			name: "7) Prepositive abbreviation",
+			args: args{
+				text: "डॉ. शर्मा अस्पताल गए। वे व्यस्त थे।",
+			},
+			want: []string{"डॉ. शर्मा अस्पताल गए।", "वे व्यस्त थे।"},
+		},
+		{
+			name: "8) Multi-period abbreviation",
+			args: args{
+				text: "यह घटना 500 ई.पू. में हुई थी। यह बहुत पुरानी है।",
+			},
+			want: []string{"यह घटना 500 ई.पू. में हुई थी।", "यह बहुत पुरानी है।"},
+		},
+		{
+			name: "9) Decimal number in Devanagari digits",
+			args: args{
+				text: "दूरी ५.५ कि.मी. है। यह पास है।",
+			},
+			want: []string{"दूरी ५.५ कि.मी. है।", "यह पास है।"},
+		},
+		{
+			name: "10) Latin acronym followed by a word",
+			args: args{
+				text: "वह दस साल से U.S. में रहता है। उसे यह देश पसंद है।",
+			},
+			want: []string{"वह दस साल से U.S. में रहता है।", "उसे यह देश पसंद है।"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("hi")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package lang
+
+import (
+	"regexp"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/replacer"
+)
+
+func newItalian() *processor.Config {
+	cfg := processor.Standard()
+	punctuationReplacer := replacer.NewPunctuationReplacer()
+	cfg.BetweenPunctuationReplacer = &betweenPunctuationReplacerItalian{
+		betweenPunctuation: replacer.NewBetweenPunctuation(punctuationReplacer),
+	}
+	cfg.Abbreviation.Abbreviations = []string{"a.c", "all", "arch", "art", "avv", "banc", "c.a", "c.c.p", "c.m", "c.p", "c.s", "c.v", "ca", "cap", "cav", "cfr", "cit", "col", "comm", "corr", "d.c", "dott", "dott.ssa", "dr", "dr.ssa", "e.p.c", "ecc", "egr", "es", "etc", "fatt", "fig", "gen", "gent", "geom", "gg", "ibid", "ill", "ill.mo", "ing", "int", "lett", "magg", "mons", "n", "nr", "ogg", "on", "p", "p.c", "p.c.c", "p.es", "p.f", "p.r", "p.s", "p.v", "pag", "pagg", "post", "pp", "prof", "prof.ssa", "prot", "racc", "rag", "ric", "rif", "s.a", "s.b.f", "s.p.a", "s.p.m", "s.r.l", "sec", "sen", "sig", "sig.na", "sig.ra", "sigg", "spett", "spett.le", "tab", "tel", "ten", "u.s", "v.p", "v.r", "v.s", "vol"}
+	cfg.Abbreviation.PrePositiveAbbreviations = []string{"arch", "avv", "cav", "col", "comm", "dott", "dott.ssa", "dr", "dr.ssa", "egr", "gen", "gent", "geom", "ill", "ill.mo", "ing", "magg", "mons", "on", "prof", "prof.ssa", "rag", "sen", "sig", "sig.na", "sig.ra", "sigg", "spett", "spett.le", "ten"}
+	cfg.Abbreviation.NumberAbbreviations = []string{"art", "cap", "fig", "n", "nr", "p", "pag", "pagg", "pp", "tab", "vol"}
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrLowerCase
+	cfg.SentenceStarters = nil
+	return cfg
+}
+
+var (
+	// an apostrophe between two letters elides a vowel, e.g. "l'Italia" or "dell'anno"
+	elisionItRegex = regexp.MustCompile(`(\p{L})'(\p{L})`)
+	// an elided article may be followed by a space, e.g. "dell' Europa",
+	// and "po'" is the truncated form of "poco"
+	elisionBeforeSpaceItRegex = regexp.MustCompile(`(?i)(^|[^\p{L}])(all|coll|d|dall|dell|l|nell|po|quell|sull|un)'(\s)`)
+)
+
+type betweenPunctuationReplacerItalian struct {
+	betweenPunctuation replacer.BetweenPunctuation
+}
+
+// Replace hides the apostrophes of elisions before the punctuation between quotes is protected,
+// so that they aren't taken as single quotes. They are put back by SubSingleQuoteRule.
+func (b *betweenPunctuationReplacerItalian) Replace(text string) string {
+	text = elisionItRegex.ReplaceAllString(text, "$1&⎋&$2")
+	text = elisionBeforeSpaceItRegex.ReplaceAllString(text, "$1$2&⎋&$3")
+	text = b.betweenPunctuation.Replace(text)
+	return text
+}
+
+var _ processor.BetweenPunctuationReplacer = (*betweenPunctuationReplacerItalian)(nil)
@@ -0,0 +1,98 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Italian(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Elisions at the start of sentences",
+			args: args{
+				text: "L'Italia è bella. L'anno prossimo ci torno.",
+			},
+			want: []string{"L'Italia è bella.", "L'anno prossimo ci torno."},
+		},
+		{
+			name: "2) Elisions inside sentences",
+			args: args{
+				text: "Quell'uomo è arrivato dall'estero. Parlò dell'Italia e dell'anno scorso.",
+			},
+			want: []string{"Quell'uomo è arrivato dall'estero.", "Parlò dell'Italia e dell'anno scorso."},
+		},
+		{
+			name: "3) Truncation followed by a capitalized word",
+			args: args{
+				text: "Ho visto l'uomo che aspettava un po' Marco e poi è partito.",
+			},
+			want: []string{"Ho visto l'uomo che aspettava un po' Marco e poi è partito."},
+		},
+		{
+			name: "4) Elided article followed by a space",
+			args: args{
+				text: "Dell'Italia si parla molto, dell' Europa no. Poi tacque.",
+			},
+			want: []string{"Dell'Italia si parla molto, dell' Europa no.", "Poi tacque."},
+		},
+		{
+			name: "5) Elisions between single quotes",
+			args: args{
+				text: "Disse: 'Sì, c'è. Era lì.' Poi andò via.",
+			},
+			want: []string{"Disse: 'Sì, c'è. Era lì.'", "Poi andò via."},
+		},
+		{
+			name: "6) Elisions before and inside single quotes",
+			args: args{
+				text: "L'avevo detto: 'Sarà l'ultima volta.' Nessuno ci credeva.",
+			},
+			want: []string{"L'avevo detto: 'Sarà l'ultima volta.'", "Nessuno ci credeva."},
+		},
+		{
+			name: "7) Titles",
+			args: args{
+				text: "Il Sig. Rossi e la Dott.ssa Bianchi sono arrivati. Il Dott. Verdi no.",
+			},
+			want: []string{"Il Sig. Rossi e la Dott.ssa Bianchi sono arrivati.", "Il Dott. Verdi no."},
+		},
+		{
+			name: "8) Abbreviations inside sentences",
+			args: args{
+				text: "Abbiamo comprato mele, pere, ecc. e poi siamo tornati. Vedi pag. 5 per i dettagli.",
+			},
+			want: []string{"Abbiamo comprato mele, pere, ecc. e poi siamo tornati.", "Vedi pag. 5 per i dettagli."},
+		},
+		{
+			name: "9) Abbreviation at the end of a sentence",
+			args: args{
+				text: "Ho comprato mele, pere ecc. Poi sono tornato.",
+			},
+			want: []string{"Ho comprato mele, pere ecc.", "Poi sono tornato."},
+		},
+		{
+			name: "10) Acronym followed by a lower case word",
+			args: args{
+				text: "Vive negli U.S. da dieci anni. Gli piace il paese.",
+			},
+			want: []string{"Vive negli U.S. da dieci anni.", "Gli piace il paese."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("it")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
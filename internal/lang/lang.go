@@ -1,7 +1,7 @@
 package lang
 
 import (
-	"fmt"
+	"sort"
 
 	"github.com/gosbd/gosbd/internal/processor"
 )
@@ -12,16 +12,37 @@ var (
 		"zh": newChinese(),
 		"ja": newJapanese(),
 		"ru": newRussian(),
+		"de": newGerman(),
+		"fr": newFrench(),
+		"es": newSpanish(),
+		"it": newItalian(),
+		"nl": newDutch(),
+		"da": newDanish(),
+		"pl": newPolish(),
+		"el": newGreek(),
+		"ar": newArabic(),
+		"fa": newPersian(),
+		"ur": newUrdu(),
+		"hi": newHindi(),
+		"mr": newMarathi(),
+		"hy": newArmenian(),
+		"am": newAmharic(),
+		"my": newBurmese(),
 	}
 )
 
-func Lang(lang string) *processor.Config {
-	if _, ok := langMap[lang]; !ok {
-		availableLangs := make([]string, 0, len(langMap))
-		for k := range langMap {
-			availableLangs = append(availableLangs, k)
-		}
-		panic(fmt.Errorf("provide valid language ID i.e. ISO code. Available codes are : %v", availableLangs))
+// Lookup returns the config of the given language and reports whether it is supported.
+func Lookup(lang string) (*processor.Config, bool) {
+	cfg, ok := langMap[lang]
+	return cfg, ok
+}
+
+// Codes returns the sorted ISO codes of the supported languages.
+func Codes() []string {
+	codes := make([]string, 0, len(langMap))
+	for k := range langMap {
+		codes = append(codes, k)
 	}
-	return langMap[lang]
+	sort.Strings(codes)
+	return codes
 }
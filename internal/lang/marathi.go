@@ -0,0 +1,20 @@
+package lang
+
+import (
+	"github.com/gosbd/gosbd/internal/processor"
+)
+
+func newMarathi() *processor.Config {
+	cfg := processor.Standard()
+	cfg.BetweenPunctuationReplacer = newBetweenPunctuationReplacerDevanagari()
+	cfg.Abbreviation.Abbreviations = []string{"इ.स", "इ.स.पू", "कि.मी", "कु", "डॉ", "ता", "पृ", "प्रा", "मि", "श्री", "सौ", "से.मी"}
+	cfg.Abbreviation.PrePositiveAbbreviations = []string{"कु", "डॉ", "प्रा", "श्री", "सौ"}
+	cfg.Abbreviation.NumberAbbreviations = []string{"ता", "पृ"}
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrAlways
+	cfg.Numbers = numbersWithDigits("०-९")
+	// Marathi usually ends sentences with a period, but the dandas are also used
+	addSentenceTerminators(cfg, "।", "॥")
+	cfg.SubSymbolsRules.All = append(cfg.SubSymbolsRules.All, dandaRule, doubleDandaRule)
+	cfg.SentenceStarters = nil
+	return cfg
+}
@@ -0,0 +1,98 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Marathi(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Period",
+			args: args{
+				text: "आज दसरा आहे. आज खूप शुभ दिवस आहे.",
+			},
+			want: []string{"आज दसरा आहे.", "आज खूप शुभ दिवस आहे."},
+		},
+		{
+			name: "2) Semicolon",
+			args: args{
+				text: "ढग खूप गर्जत होते; पण पाऊस पडत नव्हता.",
+			},
+			want: []string{"ढग खूप गर्जत होते; पण पाऊस पडत नव्हता."},
+		},
+		{
+			name: "3) Exclamation point",
+			args: args{
+				text: "शाब्बास! तू केलेले काम खरोखर उत्तम आहे.",
+			},
+			want: []string{"शाब्बास!", "तू केलेले काम खरोखर उत्तम आहे."},
+		},
+		{
+			name: "4) Comma",
+			args: args{
+				text: "जर तू लवकर उठलास, तर तू लवकर तयार होशील.",
+			},
+			want: []string{"जर तू लवकर उठलास, तर तू लवकर तयार होशील."},
+		},
+		{
+			name: "5) Danda",
+			args: args{
+				text: "तो घरी गेला। तिथे कोणी नव्हते।",
+			},
+			want: []string{"तो घरी गेला।", "तिथे कोणी नव्हते।"},
+		},
+		{
+			name: "6) Period inside quotes",
+			args: args{
+				text: "तो म्हणाला, “मी येईन.” मग तो गेला.",
+			},
+			want: []string{"तो म्हणाला, “मी येईन.” मग तो गेला."},
+		},
+		{
+			name: "7) Prepositive abbreviation",
+			args: args{
+				text: "डॉ. पाटील आज येणार आहेत. ते वेळेवर येतील.",
+			},
+			want: []string{"डॉ. पाटील आज येणार आहेत.", "ते वेळेवर येतील."},
+		},
+		{
+			name: "8) Multi-period abbreviation followed by a number in Devanagari digits",
+			args: args{
+				text: "शिवाजी महाराजांचा जन्म इ.स. १६३० मध्ये झाला. ते महान राजे होते.",
+			},
+			want: []string{"शिवाजी महाराजांचा जन्म इ.स. १६३० मध्ये झाला.", "ते महान राजे होते."},
+		},
+		{
+			name: "9) Decimal number in Devanagari digits",
+			args: args{
+				text: "अंतर ३.५ कि.मी. आहे. ते जवळ आहे.",
+			},
+			want: []string{"अंतर ३.५ कि.मी. आहे.", "ते जवळ आहे."},
+		},
+		{
+			name: "10) Latin acronym followed by a word",
+			args: args{
+				text: "तो दहा वर्षांपासून U.S. मध्ये राहतो. त्याला हा देश आवडतो.",
+			},
+			want: []string{"तो दहा वर्षांपासून U.S. मध्ये राहतो.", "त्याला हा देश आवडतो."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("mr")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package lang
+
+import (
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+func newPersian() *processor.Config {
+	cfg := processor.Standard()
+	cfg.BetweenPunctuationReplacer = newBetweenPunctuationReplacerArabic()
+	cfg.Abbreviation.Abbreviations = []string{"ج", "ر.ک", "ص", "صص", "ق.م", "ن.ک", "ه.ش", "ه.ق"}
+	cfg.Abbreviation.PrePositiveAbbreviations = nil
+	cfg.Abbreviation.NumberAbbreviations = nil
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrAlways
+	// Persian and Arabic-Indic digits, e.g. "۳.۱۴" or "٣.١٤"
+	cfg.Numbers = numbersWithDigits("۰-۹٠-٩")
+	// as in pySBD, a colon ends a sentence unless it is followed by a number, e.g. "۹:۳۰"
+	addSentenceTerminators(cfg, "؟", "۔", ":")
+	cfg.NonSentenceBoundaryRules = rule.Rules{colonNotFollowedBySpaceRule}
+	cfg.SubSymbolsRules.All = append(cfg.SubSymbolsRules.All, arabicQuestionMarkRule, arabicFullStopRule)
+	cfg.SentenceStarters = nil
+	return cfg
+}
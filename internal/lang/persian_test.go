@@ -0,0 +1,105 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Persian(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Period and question mark",
+			args: args{
+				text: "خوشبختم، آقای رضا. شما کجایی هستید؟ من از تهران هستم.",
+			},
+			want: []string{"خوشبختم، آقای رضا.", "شما کجایی هستید؟", "من از تهران هستم."},
+		},
+		{
+			name: "2) Colon",
+			args: args{
+				text: "توجه: این یک هشدار است.",
+			},
+			want: []string{"توجه:", "این یک هشدار است."},
+		},
+		{
+			name: "3) Arabic full stop",
+			args: args{
+				text: "من به بازار رفتم۔ نان خریدم۔",
+			},
+			want: []string{"من به بازار رفتم۔", "نان خریدم۔"},
+		},
+		{
+			name: "4) Zero-width non-joiners inside words",
+			args: args{
+				text: "من می\u200cخواهم به خانه بروم. آن\u200cها هم می\u200cآیند.",
+			},
+			want: []string{"من می\u200cخواهم به خانه بروم.", "آن\u200cها هم می\u200cآیند."},
+		},
+		{
+			name: "5) Decimal number in Persian digits",
+			args: args{
+				text: "عدد پی تقریبا ۳.۱۴ است. این عدد مهم است.",
+			},
+			want: []string{"عدد پی تقریبا ۳.۱۴ است.", "این عدد مهم است."},
+		},
+		{
+			name: "6) Decimal number in Arabic-Indic digits",
+			args: args{
+				text: "عدد پی تقریبا ٣.١٤ است. این عدد مهم است.",
+			},
+			want: []string{"عدد پی تقریبا ٣.١٤ است.", "این عدد مهم است."},
+		},
+		{
+			name: "7) Colon between numbers in Persian digits",
+			args: args{
+				text: "جلسه ساعت ۹:۳۰ شروع می\u200cشود. لطفا به\u200cموقع بیایید.",
+			},
+			want: []string{"جلسه ساعت ۹:۳۰ شروع می\u200cشود.", "لطفا به\u200cموقع بیایید."},
+		},
+		{
+			name: "8) Question inside guillemets",
+			args: args{
+				text: "او پرسید «آیا می\u200cآیی؟» و رفت. من ماندم.",
+			},
+			want: []string{"او پرسید «آیا می\u200cآیی؟» و رفت.", "من ماندم."},
+		},
+		{
+			name: "9) Abbreviation followed by a number",
+			args: args{
+				text: "به ص. ۱۲ نگاه کنید. آنجا توضیح داده شده است.",
+			},
+			want: []string{"به ص. ۱۲ نگاه کنید.", "آنجا توضیح داده شده است."},
+		},
+		{
+			name: "10) Multi-period abbreviation",
+			args: args{
+				text: "این کتاب در ۵۰۰ ق.م. نوشته شده است. بسیار قدیمی است.",
+			},
+			want: []string{"این کتاب در ۵۰۰ ق.م. نوشته شده است.", "بسیار قدیمی است."},
+		},
+		{
+			name: "11) Latin acronym followed by a word",
+			args: args{
+				text: "او ده سال است که در U.S. زندگی می\u200cکند. او این کشور را دوست دارد.",
+			},
+			want: []string{"او ده سال است که در U.S. زندگی می\u200cکند.", "او این کشور را دوست دارد."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("fa")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
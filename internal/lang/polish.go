@@ -0,0 +1,21 @@
+package lang
+
+import (
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+func newPolish() *processor.Config {
+	cfg := processor.Standard()
+	cfg.Abbreviation.Abbreviations = []string{"abp", "adm", "al", "ang", "art", "b", "bp", "c.b.d.o", "c.d", "cd", "ds", "dn", "doc", "dr", "franc", "gen", "gm", "godz", "gr", "hab", "im", "inż", "itd", "itp", "jw", "kpt", "ks", "lit", "lp", "m.in", "m.st", "mgr", "mjr", "mld", "mln", "n.e", "niem", "np", "nr", "o.o", "ob", "obyw", "ok", "os", "p", "p.n.e", "pkt", "pl", "płk", "por", "pow", "poz", "ppłk", "prof", "pt", "r", "red", "rozdz", "ryc", "rys", "s", "sp", "str", "św", "tab", "tel", "tj", "tys", "tzn", "tzw", "ul", "ur", "ust", "w", "wg", "woj", "wyd", "ww", "zm", "zob", "zł", "łac"}
+	// these are always followed by a name, an address or an example,
+	// e.g. "prof. dr hab. inż. Nowak", "ul. Długa" or "m.in. Kraków"
+	cfg.Abbreviation.PrePositiveAbbreviations = []string{"abp", "adm", "al", "bp", "doc", "dr", "gen", "hab", "im", "inż", "kpt", "ks", "m.in", "mgr", "mjr", "np", "płk", "ppłk", "prof", "św", "tj", "tzn", "ul", "wg"}
+	cfg.Abbreviation.NumberAbbreviations = []string{"art", "godz", "nr", "p", "pkt", "poz", "rozdz", "ryc", "rys", "s", "str", "tab", "tel", "ust"}
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrLowerCase
+	cfg.Numbers.OrdinalRules = rule.Rules{
+		ordinalNumberBeforeLowerCaseRule,
+	}
+	cfg.SentenceStarters = nil
+	return cfg
+}
@@ -0,0 +1,133 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Polish(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Simple period to end sentence",
+			args: args{
+				text: "To jest zdanie. To jest drugie zdanie.",
+			},
+			want: []string{"To jest zdanie.", "To jest drugie zdanie."},
+		},
+		{
+			name: "2) Question mark and exclamation point",
+			args: args{
+				text: "Czy przyjdziesz jutro? Mam nadzieję, że tak!",
+			},
+			want: []string{"Czy przyjdziesz jutro?", "Mam nadzieję, że tak!"},
+		},
+		{
+			name: "3) Lower case abbreviation followed by a lower case word",
+			args: args{
+				text: "Kupiłem owoce, np. jabłka i gruszki. Były smaczne.",
+			},
+			want: []string{"Kupiłem owoce, np. jabłka i gruszki.", "Były smaczne."},
+		},
+		{
+			name: "4) Abbreviation followed by a capitalized example",
+			args: args{
+				text: "Odwiedziłem kilka miast, np. Kraków i Gdańsk. Było pięknie.",
+			},
+			want: []string{"Odwiedziłem kilka miast, np. Kraków i Gdańsk.", "Było pięknie."},
+		},
+		{
+			name: "5) Multi-period abbreviation followed by a word with a diacritic",
+			args: args{
+				text: "Przyszło wielu gości, m.in. Żaneta i Łukasz. Było wesoło.",
+			},
+			want: []string{"Przyszło wielu gości, m.in. Żaneta i Łukasz.", "Było wesoło."},
+		},
+		{
+			name: "6) Abbreviation followed by a number",
+			args: args{
+				text: "Spotkanie jest o godz. 12. Proszę nie spóźnić się.",
+			},
+			want: []string{"Spotkanie jest o godz. 12.", "Proszę nie spóźnić się."},
+		},
+		{
+			name: "7) Street abbreviation",
+			args: args{
+				text: "Mieszka przy ul. Długiej 5. Jest tam od lat.",
+			},
+			want: []string{"Mieszka przy ul. Długiej 5.", "Jest tam od lat."},
+		},
+		{
+			name: "8) Abbreviations followed by a number and a word with a diacritic",
+			args: args{
+				text: "To trwa ok. 5 minut, tzn. niedługo. Czekamy.",
+			},
+			want: []string{"To trwa ok. 5 minut, tzn. niedługo.", "Czekamy."},
+		},
+		{
+			name: "9) Ordinal date",
+			args: args{
+				text: "Urodził się 12. maja 1990 r. w Krakowie. Potem wyjechał.",
+			},
+			want: []string{"Urodził się 12. maja 1990 r. w Krakowie.", "Potem wyjechał."},
+		},
+		{
+			name: "10) Ordinal number",
+			args: args{
+				text: "Zajął 3. miejsce. Był zadowolony.",
+			},
+			want: []string{"Zajął 3. miejsce.", "Był zadowolony."},
+		},
+		{
+			name: "11) Abbreviation at the end of a sentence",
+			args: args{
+				text: "Wydarzyło się to w 1999 r. Nikt tego nie pamięta.",
+			},
+			want: []string{"Wydarzyło się to w 1999 r.", "Nikt tego nie pamięta."},
+		},
+		{
+			name: "12) Multiple titles",
+			args: args{
+				text: "Wykład prowadził prof. dr hab. inż. Jan Nowak. Był ciekawy.",
+			},
+			want: []string{"Wykład prowadził prof. dr hab. inż. Jan Nowak.", "Był ciekawy."},
+		},
+		{
+			name: "13) Company name",
+			args: args{
+				text: "Firma ABC sp. z o.o. zatrudnia ok. 200 osób. Ma siedzibę w Warszawie.",
+			},
+			want: []string{"Firma ABC sp. z o.o. zatrudnia ok. 200 osób.", "Ma siedzibę w Warszawie."},
+		},
+		{
+			name: "14) Etc. at the end of a sentence",
+			args: args{
+				text: "Kupiłem chleb, mleko itd. Potem wróciłem do domu.",
+			},
+			want: []string{"Kupiłem chleb, mleko itd.", "Potem wróciłem do domu."},
+		},
+		{
+			name: "15) Acronym followed by a lower case word",
+			args: args{
+				text: "Mieszka w U.S. od dziesięciu lat. Lubi ten kraj.",
+			},
+			want: []string{"Mieszka w U.S. od dziesięciu lat.", "Lubi ten kraj."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("pl")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
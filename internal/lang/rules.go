@@ -0,0 +1,120 @@
+package lang
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/replacer"
+	"github.com/gosbd/gosbd/internal/rule"
+)
+
+// replacePeriodOfAbbrLowerCase keeps the period following an abbreviation
+// unless a capitalized word follows it. Unlike the default rule,
+// lower case letters with diacritics such as "à" or "ñ" are recognized.
+func replacePeriodOfAbbrLowerCase(abbr, next string) bool {
+	return periodOfAbbrNextLowerCaseRegex.MatchString(next)
+}
+
+var periodOfAbbrNextLowerCaseRegex = regexp.MustCompile(`\A([.:\-?,;]|\s+(\p{Ll}|\d|\())`)
+
+// replacePeriodOfAbbrAlways keeps every period following an abbreviation, regardless of the text following it,
+// for the languages without letter case telling the start of the next sentence.
+func replacePeriodOfAbbrAlways(abbr, next string) bool {
+	return true
+}
+
+// The standard rules only recognize ASCII lower case letters after a mid-sentence punctuation.
+// These rules are shared by the languages which need to recognize any lower case letter.
+var (
+	exclamationPointBeforeCommaMidSentenceRule = rule.NewRule(regexp.MustCompile(`!(,\s\p{Ll})`), "&ᓴ&$1")
+	exclamationPointMidSentenceRule            = rule.NewRule(regexp.MustCompile(`!(\s\p{Ll})`), "&ᓴ&$1")
+	questionMarkMidSentenceRule                = rule.NewRule(regexp.MustCompile(`\?(\s\p{Ll})`), "&ᓷ&$1")
+)
+
+// Languages which write ordinal numbers with a period, e.g. Danish "den 5. maj" or Polish "12. maja".
+// A sentence starts with a capital letter, so a number followed by a period and a lower case word is an ordinal number.
+var ordinalNumberBeforeLowerCaseRule = rule.NewRule(regexp.MustCompile(`(\d)\.(\s+\p{Ll})`), "$1∯$2")
+
+// Rubular: http://rubular.com/r/RX5HIXyYa1
+// ReplaceColonBetweenNumbersRule = Rule(r'(?<=\d):(?=\d)', '♭')
+// modification: any colon which isn't followed by a space, e.g. in "09:30" or a URL,
+// for the languages in which a colon ends a sentence.
+var colonNotFollowedBySpaceRule = rule.NewRule(regexp.MustCompile(`:(\S)`), "♭$1")
+
+// addSentenceTerminators makes the given punctuations end a sentence like a period,
+// adding them to the punctuations checked by the processor and to the sentence boundary rules.
+func addSentenceTerminators(cfg *processor.Config, terminators ...string) {
+	cfg.Punctuations = append(cfg.Punctuations, terminators...)
+	cfg.SentenceBoundaryRules = processor.NewSentenceBoundaryRules(terminators...)
+}
+
+// replacePeriodsInsideAbbreviations replaces the periods inside the abbreviations matched by re,
+// e.g. "κ.τ.λ.", as the standard rules only recognize ASCII letters. The match must end with
+// the last period, which is kept as whether it ends a sentence has been decided by the abbreviation replacer.
+func replacePeriodsInsideAbbreviations(re *regexp.Regexp, text string) string {
+	return re.ReplaceAllStringFunc(text, func(match string) string {
+		last := strings.LastIndexAny(match, ".∯")
+		return strings.ReplaceAll(match[:last], ".", "∯") + match[last:]
+	})
+}
+
+// numbersWithDigits returns the number rules of the standard config,
+// recognizing the given digits besides the ASCII ones, e.g. "۰-۹" for the Persian digits.
+func numbersWithDigits(digits string) processor.Numbers {
+	d := `[\d` + digits + `]`
+	return processor.Numbers{
+		PeriodBeforeNumberRule:             rule.NewRule(regexp.MustCompile(`\.(`+d+`)`), "∯$1"),
+		NumberAfterPeriodBeforeLetterRule:  rule.NewRule(regexp.MustCompile(`(`+d+`)\.(\S)`), "$1∯$2"),
+		NewLineNumberPeriodSpaceLetterRule: rule.NewRule(regexp.MustCompile(`(\r`+d+`)\.((\s\S)|\))`), "$1∯$2"),
+		StartLineNumberPeriodRule:          rule.NewRule(regexp.MustCompile(`(^`+d+`)\.((\s\S)|\))`), "$1∯$2"),
+		StartLineTwoDigitNumberPeriodRule:  rule.NewRule(regexp.MustCompile(`(^`+d+d+`)\.((\s\S)|\))`), "$1∯$2"),
+	}
+}
+
+// quoteWords returns a regular expression alternation matching any of the words.
+func quoteWords(words []string) string {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = regexp.QuoteMeta(w)
+	}
+	return strings.Join(quoted, "|")
+}
+
+// betweenPunctuationReplacerScript protects the punctuation between quotes or parens in the languages
+// which end sentences with the punctuations of their script, e.g. "।" in Hindi, so that they don't end a sentence.
+type betweenPunctuationReplacerScript struct {
+	// betweenPunctuation matches the text between quotes or parens.
+	betweenPunctuation *regexp.Regexp
+	// placeholder replaces the punctuations of the script with the placeholders
+	// put back by the sub symbols rules.
+	placeholder *strings.Replacer
+	// multiPeriodAbbreviation matches the abbreviations written with periods, e.g. "ई.पू.", if set.
+	multiPeriodAbbreviation *regexp.Regexp
+	punctuationReplacer     replacer.Punctuation
+	standard                replacer.BetweenPunctuation
+}
+
+func newBetweenPunctuationReplacerScript(betweenPunctuation *regexp.Regexp, placeholder *strings.Replacer, multiPeriodAbbreviation *regexp.Regexp) *betweenPunctuationReplacerScript {
+	punctuationReplacer := replacer.NewPunctuationReplacer()
+	return &betweenPunctuationReplacerScript{
+		betweenPunctuation:      betweenPunctuation,
+		placeholder:             placeholder,
+		multiPeriodAbbreviation: multiPeriodAbbreviation,
+		punctuationReplacer:     punctuationReplacer,
+		standard:                replacer.NewBetweenPunctuation(punctuationReplacer),
+	}
+}
+
+func (b *betweenPunctuationReplacerScript) Replace(text string) string {
+	if b.multiPeriodAbbreviation != nil {
+		text = replacePeriodsInsideAbbreviations(b.multiPeriodAbbreviation, text)
+	}
+	replaceFunc := b.punctuationReplacer.ReplaceFunc(processor.PunctuationMatchTypeNone)
+	text = b.betweenPunctuation.ReplaceAllStringFunc(text, func(match string) string {
+		return b.placeholder.Replace(replaceFunc(match))
+	})
+	return b.standard.Replace(text)
+}
+
+var _ processor.BetweenPunctuationReplacer = (*betweenPunctuationReplacerScript)(nil)
@@ -2,6 +2,7 @@ package lang_test
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/gosbd/gosbd"
@@ -284,3 +285,13 @@ func Test_Russian(t *testing.T) {
 		})
 	}
 }
+
+func Benchmark_Russian(b *testing.B) {
+	text := strings.Repeat("Объем составляет 5 куб.м. Он живет на ул. Ленина, д. 5. Цена 100 руб. за кг. Проф. Иванов сказал: «Приходите в 10 ч. утра». ", 20)
+	sg := gosbd.NewSegmenter("ru")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sg.Segment(text)
+	}
+}
@@ -0,0 +1,55 @@
+package lang
+
+import (
+	"regexp"
+
+	"github.com/gosbd/gosbd/internal/processor"
+	"github.com/gosbd/gosbd/internal/replacer"
+)
+
+func newSpanish() *processor.Config {
+	cfg := processor.Standard()
+	punctuationReplacer := replacer.NewPunctuationReplacer()
+	cfg.BetweenPunctuationReplacer = &betweenPunctuationReplacerSpanish{
+		punctuationReplacer: punctuationReplacer,
+		betweenPunctuation:  replacer.NewBetweenPunctuation(punctuationReplacer),
+	}
+	cfg.Abbreviation.Abbreviations = []string{"a.c", "a/c", "abr", "adj", "admón", "afmo", "ago", "almte", "ap", "apdo", "arq", "art", "atte", "av", "avda", "bco", "bibl", "c.f", "c.g", "c/c", "c/u", "cap", "cc.aa", "cdad", "cm", "co", "cra", "cta", "cv", "d.e.p", "da", "dcha", "dcho", "dep", "dic", "dicc", "dir", "dn", "doc", "dom", "dpto", "dr", "dra", "dto", "ee", "ej", "entlo", "esq", "etc", "excma", "excmo", "ext", "f.c", "fca", "fdo", "febr", "ff.cc", "fig", "fil", "fra", "g.p", "g/p", "gob", "gr", "gral", "grs", "hnos", "hs", "igl", "iltre", "ilma", "ilmo", "imp", "impr", "impto", "incl", "ing", "inst", "izdo", "izq", "izqdo", "j.c", "jue", "jul", "jun", "kg", "km", "lcdo", "ldo", "let", "lic", "ltd", "lun", "mar", "may", "mg", "min", "mié", "mm", "máx", "mín", "mt", "n.b", "no", "nov", "núm", "oct", "p", "p.a", "p.d", "p.ej", "p.v.p", "párrf", "ppal", "prev", "prof", "prov", "ptas", "pts", "pza", "pág", "págs", "párr", "q.e.g.e", "q.e.p.d", "q.e.s.m", "reg", "rep", "rte", "s", "s.a", "s.a.r", "s.e", "s.l", "s.r.c", "s.r.l", "s.s.s", "s/n", "sdad", "seg", "sept", "sig", "sr", "sra", "sres", "srta", "sta", "sto", "sáb", "t.v.e", "tamb", "tel", "tfno", "ud", "uds", "univ", "uu", "v.b", "v.e", "vd", "vds", "vid", "vie", "vol", "vs", "vto"}
+	cfg.Abbreviation.PrePositiveAbbreviations = []string{"avda", "dn", "dr", "dra", "excma", "excmo", "ilma", "ilmo", "ing", "lcdo", "ldo", "lic", "prof", "sr", "sra", "sres", "srta", "sta", "sto"}
+	cfg.Abbreviation.NumberAbbreviations = []string{"art", "cra", "ext", "no", "núm", "p", "pág", "págs", "pp", "tel"}
+	cfg.Abbreviation.ReplacePeriodOfAbbrFn = replacePeriodOfAbbrLowerCase
+	// a question or an exclamation embedded in a sentence is followed by a lower case word,
+	// e.g. "Dijo que ¿vendría? no lo sé."
+	cfg.ExclamationPointRules.All = append(cfg.ExclamationPointRules.All,
+		exclamationPointBeforeCommaMidSentenceRule,
+		exclamationPointMidSentenceRule,
+		questionMarkMidSentenceRule,
+	)
+	cfg.SentenceStarters = nil
+	return cfg
+}
+
+var (
+	betweenInvertedQuestionMarksEsRegex     = regexp.MustCompile(`¿[^¿?]*\?`)
+	betweenInvertedExclamationPointsEsRegex = regexp.MustCompile(`¡[^¡!]*!`)
+)
+
+type betweenPunctuationReplacerSpanish struct {
+	punctuationReplacer replacer.Punctuation
+	betweenPunctuation  replacer.BetweenPunctuation
+}
+
+// Replace protects the punctuation between paired inverted marks, e.g. "¿Vino el Dr. Pérez…?",
+// leaving the closing mark to end the sentence.
+func (b *betweenPunctuationReplacerSpanish) Replace(text string) string {
+	replaceFunc := b.punctuationReplacer.ReplaceFunc(processor.PunctuationMatchTypeNone)
+	replaceInner := func(match string) string {
+		return replaceFunc(match[:len(match)-1]) + match[len(match)-1:]
+	}
+	text = betweenInvertedQuestionMarksEsRegex.ReplaceAllStringFunc(text, replaceInner)
+	text = betweenInvertedExclamationPointsEsRegex.ReplaceAllStringFunc(text, replaceInner)
+	text = b.betweenPunctuation.Replace(text)
+	return text
+}
+
+var _ processor.BetweenPunctuationReplacer = (*betweenPunctuationReplacerSpanish)(nil)
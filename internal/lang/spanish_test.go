@@ -0,0 +1,133 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Spanish(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Question mark to end sentence",
+			args: args{
+				text: "¿Cómo está hoy? Espero que muy bien.",
+			},
+			want: []string{"¿Cómo está hoy?", "Espero que muy bien."},
+		},
+		{
+			name: "2) Exclamation point to end sentence",
+			args: args{
+				text: "¡Hola señorita! Espero que muy bien.",
+			},
+			want: []string{"¡Hola señorita!", "Espero que muy bien."},
+		},
+		{
+			name: "3) Pre-positive abbreviations",
+			args: args{
+				text: "Hola Srta. Ledesma. Buenos días, soy el Lic. Naser Pastoriza, y él es mi padre, el Dr. Naser.",
+			},
+			want: []string{
+				"Hola Srta. Ledesma.",
+				"Buenos días, soy el Lic. Naser Pastoriza, y él es mi padre, el Dr. Naser.",
+			},
+		},
+		{
+			name: "4) Numbers in exclamations",
+			args: args{
+				text: "¡La casa cuesta $170.500.000,00! ¡Muy costosa! Se prevé una disminución del 12.5% para el próximo año.",
+			},
+			want: []string{
+				"¡La casa cuesta $170.500.000,00!",
+				"¡Muy costosa!",
+				"Se prevé una disminución del 12.5% para el próximo año.",
+			},
+		},
+		{
+			name: "5) Quotation followed by a comma",
+			args: args{
+				text: "«Ninguna mente extraordinaria está exenta de un toque de demencia.», dijo Aristóteles.",
+			},
+			want: []string{"«Ninguna mente extraordinaria está exenta de un toque de demencia.», dijo Aristóteles."},
+		},
+		{
+			name: "6) Question embedded in a sentence",
+			args: args{
+				text: "Dijo que ¿vendría? no lo sé.",
+			},
+			want: []string{"Dijo que ¿vendría? no lo sé."},
+		},
+		{
+			name: "7) Exclamation embedded in a sentence",
+			args: args{
+				text: "Le dijo: ¡sal de aquí! y él se fue.",
+			},
+			want: []string{"Le dijo: ¡sal de aquí! y él se fue."},
+		},
+		{
+			name: "8) Abbreviation inside a question",
+			args: args{
+				text: "¿Vino el Dr. Pérez? No, vino la Dra. López.",
+			},
+			want: []string{"¿Vino el Dr. Pérez?", "No, vino la Dra. López."},
+		},
+		{
+			name: "9) Ellipsis inside a question",
+			args: args{
+				text: "¿Viste la película... o no? Yo sí.",
+			},
+			want: []string{"¿Viste la película... o no?", "Yo sí."},
+		},
+		{
+			name: "10) Consecutive exclamation and question",
+			args: args{
+				text: "¡Qué bien! ¿Y tú?",
+			},
+			want: []string{"¡Qué bien!", "¿Y tú?"},
+		},
+		{
+			name: "11) Number abbreviations",
+			args: args{
+				text: "Ud. puede ver la pág. 15. Allí está todo.",
+			},
+			want: []string{"Ud. puede ver la pág. 15.", "Allí está todo."},
+		},
+		{
+			name: "12) Abbreviation followed by a lower case word",
+			args: args{
+				text: "Los libros, cuadernos, etc. están en la mesa. Recógelos.",
+			},
+			want: []string{"Los libros, cuadernos, etc. están en la mesa.", "Recógelos."},
+		},
+		{
+			name: "13) Decimal number",
+			args: args{
+				text: "El volumen de los cuerpos es de 3.5 cm. Se debe multiplicar.",
+			},
+			want: []string{"El volumen de los cuerpos es de 3.5 cm.", "Se debe multiplicar."},
+		},
+		{
+			name: "14) Acronym followed by a lower case word",
+			args: args{
+				text: "Vive en los U.S. desde hace diez años. Le gusta el país.",
+			},
+			want: []string{"Vive en los U.S. desde hace diez años.", "Le gusta el país."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("es")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
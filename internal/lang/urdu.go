@@ -0,0 +1,19 @@
+package lang
+
+import (
+	"github.com/gosbd/gosbd/internal/processor"
+)
+
+func newUrdu() *processor.Config {
+	cfg := processor.Standard()
+	cfg.BetweenPunctuationReplacer = newBetweenPunctuationReplacerArabic()
+	// pySBD defines no Urdu abbreviations
+	cfg.Abbreviation.Abbreviations = nil
+	cfg.Abbreviation.PrePositiveAbbreviations = nil
+	cfg.Abbreviation.NumberAbbreviations = nil
+	cfg.Numbers = numbersWithDigits("۰-۹")
+	addSentenceTerminators(cfg, "۔", "؟")
+	cfg.SubSymbolsRules.All = append(cfg.SubSymbolsRules.All, arabicQuestionMarkRule, arabicFullStopRule)
+	cfg.SentenceStarters = nil
+	return cfg
+}
@@ -0,0 +1,77 @@
+package lang_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+func Test_Urdu(t *testing.T) {
+	type args struct {
+		text string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "1) Question mark and Arabic full stop",
+			args: args{
+				text: "کیا حال ہے؟ ميرا نام ___ ەے۔ میں حالا تاوان دےدوں؟",
+			},
+			want: []string{"کیا حال ہے؟", "ميرا نام ___ ەے۔", "میں حالا تاوان دےدوں؟"},
+		},
+		{
+			name: "2) Exclamation point",
+			args: args{
+				text: "واہ! کتنا اچھا ہے۔",
+			},
+			want: []string{"واہ!", "کتنا اچھا ہے۔"},
+		},
+		{
+			name: "3) Honorific sign following a name",
+			args: args{
+				text: "حضرت محمد ﷺ نے فرمایا۔ علم حاصل کرو۔",
+			},
+			want: []string{"حضرت محمد ﷺ نے فرمایا۔", "علم حاصل کرو۔"},
+		},
+		{
+			name: "4) Honorific mark on a name",
+			args: args{
+				text: "حضرت علیؓ بہادر تھے۔ وہ مشہور ہیں۔",
+			},
+			want: []string{"حضرت علیؓ بہادر تھے۔", "وہ مشہور ہیں۔"},
+		},
+		{
+			name: "5) Decimal number in Urdu digits",
+			args: args{
+				text: "اس کی قیمت ۲.۵ روپے ہے۔ یہ سستا ہے۔",
+			},
+			want: []string{"اس کی قیمت ۲.۵ روپے ہے۔", "یہ سستا ہے۔"},
+		},
+		{
+			name: "6) Question inside guillemets",
+			args: args{
+				text: "اس نے کہا «کیا تم آؤ گے؟» اور چلا گیا۔ میں رک گیا۔",
+			},
+			want: []string{"اس نے کہا «کیا تم آؤ گے؟» اور چلا گیا۔", "میں رک گیا۔"},
+		},
+		{
+			name: "7) Latin acronym followed by a word",
+			args: args{
+				text: "وہ دس سال سے U.S. میں رہتا ہے۔ اسے یہ ملک پسند ہے۔",
+			},
+			want: []string{"وہ دس سال سے U.S. میں رہتا ہے۔", "اسے یہ ملک پسند ہے۔"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sg := gosbd.NewSegmenter("ur")
+			if got := sg.Segment(tt.args.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.Segment() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 
@@ -16,7 +17,20 @@ type Abbreviation struct {
 	Abbreviations                   []string
 	PrePositiveAbbreviations        []string
 	NumberAbbreviations             []string
-	ReplacePeriodOfAbbrFn           func(text, abbr string) string
+	// ReplacePeriodOfAbbrFn overrides whether the period following an abbreviation,
+	// which is neither pre-positive nor a number abbreviation, belongs to it.
+	// next is the text following the period. It isn't called if a sentence starter follows the period,
+	// which then ends the sentence.
+	ReplacePeriodOfAbbrFn func(abbr, next string) bool
+}
+
+func (a Abbreviation) IsAbbreviation(abbr string) bool {
+	for _, a := range a.Abbreviations {
+		if a == abbr {
+			return true
+		}
+	}
+	return false
 }
 
 func (a Abbreviation) IsPrePositive(abbr string) bool {
@@ -69,16 +83,20 @@ type Numbers struct {
 	NewLineNumberPeriodSpaceLetterRule rule.Rule
 	StartLineNumberPeriodRule          rule.Rule
 	StartLineTwoDigitNumberPeriodRule  rule.Rule
+	// OrdinalRules protect the periods of ordinal numbers in languages which write them
+	// with a period, e.g. "am 3. Oktober" in German. They are applied after the other rules.
+	OrdinalRules rule.Rules
 }
 
 func (n Numbers) All() rule.Rules {
-	return rule.Rules{
+	rules := rule.Rules{
 		n.PeriodBeforeNumberRule,
 		n.NumberAfterPeriodBeforeLetterRule,
 		n.NewLineNumberPeriodSpaceLetterRule,
 		n.StartLineNumberPeriodRule,
 		n.StartLineTwoDigitNumberPeriodRule,
 	}
+	return append(rules, n.OrdinalRules...)
 }
 
 type SubSymbolsRules struct {
@@ -188,8 +206,31 @@ type Config struct {
 	ReinsertEllipsisRules                  ReinsertEllipsisRules
 	SubSingleQuoteRule                     rule.Rule
 	SentenceBoundaryRules                  SentenceBoundaryRules
-	SentenceStarters                       []string
-	BetweenPunctuationReplacer             BetweenPunctuationReplacer
+	// NonSentenceBoundaryRules hide the punctuations which don't end a sentence
+	// before the sentence boundary rules are applied, e.g. a colon between numbers
+	// in languages which end sentences with a colon.
+	NonSentenceBoundaryRules   rule.Rules
+	SentenceStarters           []string
+	BetweenPunctuationReplacer BetweenPunctuationReplacer
+}
+
+// Clone returns a copy of the config whose word lists can be modified
+// without affecting the original config.
+func (c *Config) Clone() *Config {
+	clone := *c
+	clone.Abbreviation.Abbreviations = cloneStrings(c.Abbreviation.Abbreviations)
+	clone.Abbreviation.PrePositiveAbbreviations = cloneStrings(c.Abbreviation.PrePositiveAbbreviations)
+	clone.Abbreviation.NumberAbbreviations = cloneStrings(c.Abbreviation.NumberAbbreviations)
+	clone.SentenceStarters = cloneStrings(c.SentenceStarters)
+	clone.Punctuations = cloneStrings(c.Punctuations)
+	return &clone
+}
+
+func cloneStrings(s []string) []string {
+	if s == nil {
+		return nil
+	}
+	return append(make([]string, 0, len(s)), s...)
 }
 
 func Standard() *Config {
@@ -487,19 +528,7 @@ func Standard() *Config {
 		},
 		SubSymbolsRules:       newSubSymbolsRules(),
 		ReinsertEllipsisRules: newReinsertEllipsisRules(),
-		SentenceBoundaryRules: SentenceBoundaryRules{
-			All: rule.Rules{
-				sentenceBoundaryRule1,
-				sentenceBoundaryRule2,
-				sentenceBoundaryRule3,
-				sentenceBoundaryRule4,
-				sentenceBoundaryRule5,
-				sentenceBoundaryRule6,
-				sentenceBoundaryRule7,
-				sentenceBoundaryRule8,
-				sentenceBoundaryRule9,
-			},
-		},
+		SentenceBoundaryRules: NewSentenceBoundaryRules(),
 	}
 }
 
@@ -631,7 +660,29 @@ var (
 	sentenceBoundaryRule4 = rule.NewRule(regexp.MustCompile(`('([^'])*[^,]')\s([A-Z])`), "$1\r$3")
 	sentenceBoundaryRule5 = rule.NewRule(regexp.MustCompile(`("([^"])*[^,]")\s([A-Z])`), "$1\r$3")
 	sentenceBoundaryRule6 = rule.NewRule(regexp.MustCompile(`(([^”])*[^,]”)\s([A-Z])`), "$1\r$3")
-	sentenceBoundaryRule7 = rule.NewRule(regexp.MustCompile(`(\S.*?[。．.！!?？ȸȹ☉☈☇☄])\s*(\S*?)`), "$1\r$2")
 	sentenceBoundaryRule8 = rule.NewRule(regexp.MustCompile(`([。．.！!? ]{2,})`), "$1\r")
-	sentenceBoundaryRule9 = rule.NewRule(regexp.MustCompile(`([。．.！!?？])`), "$1\r")
 )
+
+// NewSentenceBoundaryRules returns the sentence boundary rules of the standard config.
+// The given terminators end a sentence like a period, e.g. "।" in Hindi.
+func NewSentenceBoundaryRules(terminators ...string) SentenceBoundaryRules {
+	var class strings.Builder
+	for _, t := range terminators {
+		for _, r := range t {
+			fmt.Fprintf(&class, `\x{%x}`, r)
+		}
+	}
+	return SentenceBoundaryRules{
+		All: rule.Rules{
+			sentenceBoundaryRule1,
+			sentenceBoundaryRule2,
+			sentenceBoundaryRule3,
+			sentenceBoundaryRule4,
+			sentenceBoundaryRule5,
+			sentenceBoundaryRule6,
+			rule.NewRule(regexp.MustCompile(`(\S.*?[。．.！!?？ȸȹ☉☈☇☄`+class.String()+`])\s*(\S*?)`), "$1\r$2"),
+			sentenceBoundaryRule8,
+			rule.NewRule(regexp.MustCompile(`([。．.！!?？`+class.String()+`])`), "$1\r"),
+		},
+	}
+}
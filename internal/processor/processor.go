@@ -151,13 +151,8 @@ var (
 )
 
 func (p *Processor) sentenceBoundaryPunctuation(text string) []string {
-	// TODO: implement rules below
-	// if hasattr(self.lang, 'ReplaceColonBetweenNumbersRule'):
-	//    txt = Text(txt).apply(
-	//    self.lang.ReplaceColonBetweenNumbersRule)
-	// if hasattr(self.lang, 'ReplaceNonSentenceBoundaryCommaRule'):
-	// 	txt = Text(txt).apply(
-	// 	self.lang.ReplaceNonSentenceBoundaryCommaRule)
+	// language specific rules, e.g. ReplaceColonBetweenNumbersRule of pySBD
+	text = p.cfg.NonSentenceBoundaryRules.Apply(text)
 
 	// retain exclamation mark if it is an ending character of a given text
 	text = exclamationRegex.ReplaceAllString(text, "!")
@@ -195,6 +195,19 @@ func Test_processor_sentenceBoundaryPunctuation(t *testing.T) {
 				`He never returned.`,
 			},
 		},
+		{
+			fields: fields{
+				cfg: withSentenceBoundaryRules(Standard(), NewSentenceBoundaryRules("-", "։")),
+			},
+			args: args{
+				text: `Բարեւ։ Ինչպես ես- Լավ եմ։`,
+			},
+			want: []string{
+				`Բարեւ։`,
+				`Ինչպես ես-`,
+				`Լավ եմ։`,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -214,3 +227,8 @@ func Test_processor_sentenceBoundaryPunctuation(t *testing.T) {
 		})
 	}
 }
+
+func withSentenceBoundaryRules(cfg *Config, rules SentenceBoundaryRules) *Config {
+	cfg.SentenceBoundaryRules = rules
+	return cfg
+}
@@ -9,8 +9,29 @@ import (
 	"github.com/gosbd/gosbd/internal/processor"
 )
 
+type abbreviationKind int
+
+const (
+	abbreviationKindDefault abbreviationKind = iota
+	abbreviationKindPrePositive
+	abbreviationKindNumber
+)
+
+var (
+	prePositiveAbbrNextRegex = regexp.MustCompile(`\A(\s|:\d)`)
+	preNumberAbbrNextRegex   = regexp.MustCompile(`\A(\s\d|\s+\()`)
+	periodOfAbbrNextRegex    = regexp.MustCompile(`\A((\.|:|-|\?|,)|(\s([a-z]|I\s|I'm|I'll|\d|\()))`)
+)
+
+// AbbreviationReplacer replaces the periods of abbreviations which don't end a sentence.
+// Abbreviations are looked up in a set built once from the config,
+// so no regular expression is compiled while replacing.
 type AbbreviationReplacer struct {
 	cfg *processor.Config
+	// abbreviations maps lower-cased abbreviations to their kind.
+	abbreviations                  map[string]abbreviationKind
+	sentenceStarters               map[string]struct{}
+	abbreviationAsSentenceBoundary *regexp.Regexp
 }
 
 func (a AbbreviationReplacer) Replace(text string) string {
@@ -35,15 +56,13 @@ func (a AbbreviationReplacer) Replace(text string) string {
 	return text
 }
 
+// ReplaceAbbreviationAsSentenceBoundary restores the period of an abbreviation followed by a sentence starter.
+// The text is left unchanged without sentence starters.
 func (a AbbreviationReplacer) ReplaceAbbreviationAsSentenceBoundary(text string) string {
-	var ss []string
-	for _, s := range a.cfg.SentenceStarters {
-		ss = append(ss, fmt.Sprintf(`(\s%s\s)`, s))
+	if a.abbreviationAsSentenceBoundary == nil {
+		return text
 	}
-	sentenceStarters := strings.Join(ss, "|")
-	re := regexp.MustCompile(fmt.Sprintf(`(U∯S|U\.S|U∯K|E∯U|E\.U|U∯S∯A|U\.S\.A|I|i\.v|I\.V)∯(%s)`, sentenceStarters))
-	text = re.ReplaceAllString(text, "$1.$2")
-	return text
+	return a.abbreviationAsSentenceBoundary.ReplaceAllString(text, "$1.$2")
 }
 
 func (a AbbreviationReplacer) ReplaceMultiPeriodAbbreviations(text string) string {
@@ -54,81 +73,110 @@ func (a AbbreviationReplacer) ReplaceMultiPeriodAbbreviations(text string) strin
 	return text
 }
 
+// SearchForAbbreviationsInString replaces the periods of the abbreviations found in the text.
+// An abbreviation starts at the beginning of the text or after a whitespace and is followed by a period.
 func (a AbbreviationReplacer) SearchForAbbreviationsInString(text string) string {
-	lowered := strings.ToLower(text)
-	for _, abbr := range a.cfg.Abbreviation.Abbreviations {
-		stripped := strings.TrimSpace(abbr)
-		if !strings.Contains(lowered, stripped) {
+	var (
+		buf  strings.Builder
+		last int
+	)
+	for start := 0; start < len(text); start++ {
+		if start > 0 && !isSpace(text[start-1]) || isSpace(text[start]) {
 			continue
 		}
-
-		re := regexp.MustCompile(fmt.Sprintf(`(?i)(^|\s|\r|\n)%s`, stripped))
-		matches := re.FindAllString(text, -1)
-		if len(matches) == 0 {
-			continue
-		}
-
-		escaped := regexp.QuoteMeta(stripped)
-		reNextWord := regexp.MustCompile(fmt.Sprintf(`(?i)%s[ ](.{1})`, escaped))
-		nextWordMatches := reNextWord.FindAllStringSubmatch(text, -1)
-		for i, match := range matches {
-			text = a.ScanForReplacements(text, match, i, nextWordMatches)
+		for i := start; i < len(text) && !isSpace(text[i]); i++ {
+			if text[i] != '.' || i == start {
+				continue
+			}
+			abbr := strings.ToLower(text[start:i])
+			kind, ok := a.abbreviations[abbr]
+			if !ok || !a.isPeriodOfAbbr(kind, abbr, text[i+1:]) {
+				continue
+			}
+			buf.WriteString(text[last:i])
+			buf.WriteString("∯")
+			last = i + 1
 		}
 	}
-	return text
+	if last == 0 {
+		return text
+	}
+	buf.WriteString(text[last:])
+	return buf.String()
 }
 
-func (a AbbreviationReplacer) ScanForReplacements(text, am string, i int, nextWordMatches [][]string) string {
-	var char string
-	if len(nextWordMatches) > i && len(nextWordMatches[i]) > 1 {
-		char = nextWordMatches[i][1]
+// isPeriodOfAbbr reports whether the period following the abbreviation
+// belongs to it, given the text following the period.
+func (a AbbreviationReplacer) isPeriodOfAbbr(kind abbreviationKind, abbr, next string) bool {
+	switch kind {
+	case abbreviationKindPrePositive:
+		return prePositiveAbbrNextRegex.MatchString(next)
+	case abbreviationKindNumber:
+		return preNumberAbbrNextRegex.MatchString(next)
 	}
-	upper := char != "" && strings.ToUpper(char) == char
-	loweredMatch := strings.ToLower(strings.TrimSpace(am))
-	if !upper || a.cfg.Abbreviation.IsPrePositive(loweredMatch) {
-		if a.cfg.Abbreviation.IsPrePositive(loweredMatch) {
-			text = a.ReplacePrePositiveAbbr(text, am)
-		} else if a.cfg.Abbreviation.IsNumber(loweredMatch) {
-			text = a.ReplacePreNumberAbbr(text, am)
-		} else {
-			text = a.ReplacePeriodOfAbbr(text, am)
+	if a.cfg.Abbreviation.ReplacePeriodOfAbbrFn != nil {
+		// a sentence starter following the period ends the sentence, e.g. "usw. Danach" in German
+		if a.isFollowedBySentenceStarter(next) {
+			return false
 		}
+		return a.cfg.Abbreviation.ReplacePeriodOfAbbrFn(abbr, next)
 	}
-	return text
-}
-
-func (a AbbreviationReplacer) ReplacePrePositiveAbbr(text, abbr string) string {
-	// prepend a space to avoid needing another regex for start of string
-	text = " " + text
-	stripped := strings.TrimSpace(abbr)
-	re := regexp.MustCompile(fmt.Sprintf(`(?i)(\s%s)\.(\s|:\d+)`, stripped))
-	text = re.ReplaceAllString(text, "$1∯$2")
-	return strings.TrimLeft(text, " ")
+	return periodOfAbbrNextRegex.MatchString(next)
 }
 
-func (a AbbreviationReplacer) ReplacePreNumberAbbr(text, abbr string) string {
-	// prepend a space to avoid needing another regex for start of string
-	text = " " + text
-	stripped := strings.TrimSpace(abbr)
-	re := regexp.MustCompile(fmt.Sprintf(`(?i)(\s%s)\.(\s\d|\s+\()`, stripped))
-	text = re.ReplaceAllString(text, "$1∯$2")[1:]
-	return strings.TrimLeft(text, " ")
+// isFollowedBySentenceStarter reports whether next starts with a whitespace followed by a sentence starter.
+func (a AbbreviationReplacer) isFollowedBySentenceStarter(next string) bool {
+	if len(a.sentenceStarters) == 0 || next == "" || !isSpace(next[0]) {
+		return false
+	}
+	word := strings.TrimLeft(next, " \t\n\f\r")
+	if i := strings.IndexAny(word, " \t\n\f\r"); i != -1 {
+		word = word[:i]
+	}
+	_, ok := a.sentenceStarters[word]
+	return ok
 }
 
-func (a AbbreviationReplacer) ReplacePeriodOfAbbr(text, abbr string) string {
-	if a.cfg.Abbreviation.ReplacePeriodOfAbbrFn != nil {
-		return a.cfg.Abbreviation.ReplacePeriodOfAbbrFn(text, abbr)
+// isSpace reports whether c is a whitespace as matched by \s.
+func isSpace(c byte) bool {
+	switch c {
+	case '\t', '\n', '\f', '\r', ' ':
+		return true
 	}
-	// prepend a space to avoid needing another regex for start of string
-	text = " " + text
-	stripped := strings.TrimSpace(abbr)
-	re := regexp.MustCompile(fmt.Sprintf(`(\s%s)\.((\.|\:|-|\?|,)|(\s([a-z]|I\s|I'm|I'll|\d|\()))`, stripped))
-	text = re.ReplaceAllString(text, "$1∯$2")[1:]
-	return strings.TrimLeft(text, " ")
+	return false
 }
 
 func NewAbbreviationReplacer(cfg *processor.Config) AbbreviationReplacer {
-	return AbbreviationReplacer{cfg: cfg}
+	abbreviations := make(map[string]abbreviationKind, len(cfg.Abbreviation.Abbreviations))
+	for _, abbr := range cfg.Abbreviation.Abbreviations {
+		abbr = strings.ToLower(strings.TrimSpace(abbr))
+		switch {
+		case cfg.Abbreviation.IsPrePositive(abbr):
+			abbreviations[abbr] = abbreviationKindPrePositive
+		case cfg.Abbreviation.IsNumber(abbr):
+			abbreviations[abbr] = abbreviationKindNumber
+		default:
+			abbreviations[abbr] = abbreviationKindDefault
+		}
+	}
+	var ss []string
+	sentenceStarters := make(map[string]struct{}, len(cfg.SentenceStarters))
+	for _, s := range cfg.SentenceStarters {
+		ss = append(ss, fmt.Sprintf(`(\s%s\s)`, regexp.QuoteMeta(s)))
+		sentenceStarters[s] = struct{}{}
+	}
+	a := AbbreviationReplacer{
+		cfg:              cfg,
+		abbreviations:    abbreviations,
+		sentenceStarters: sentenceStarters,
+	}
+	// an empty alternation would match anywhere, ending a sentence at every such abbreviation.
+	if len(ss) > 0 {
+		a.abbreviationAsSentenceBoundary = regexp.MustCompile(
+			fmt.Sprintf(`(U∯S|U\.S|U∯K|E∯U|E\.U|U∯S∯A|U\.S\.A|I|i\.v|I\.V)∯(%s)`, strings.Join(ss, "|")),
+		)
+	}
+	return a
 }
 
 var _ processor.AbbreviationReplacer = (*AbbreviationReplacer)(nil)
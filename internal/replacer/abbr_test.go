@@ -67,3 +67,28 @@ func TestAbbreviationReplacer_ReplaceAbbreviationAsSentenceBoundary(t *testing.T
 		})
 	}
 }
+
+func TestAbbreviationReplacer_ReplaceAbbreviationAsSentenceBoundary_NoSentenceStarters(t *testing.T) {
+	cfg := processor.Standard()
+	cfg.SentenceStarters = nil
+	a := NewAbbreviationReplacer(cfg)
+	text := "I lived in the U∯S∯ for years∯ I∯ am here."
+	if got := a.ReplaceAbbreviationAsSentenceBoundary(text); got != text {
+		t.Errorf("AbbreviationReplacer.ReplaceAbbreviationAsSentenceBoundary() = %q, want %q", got, text)
+	}
+}
+
+const benchmarkAbbreviationText = "At 5 a.m. Mr. Smith went to the bank. He left the bank at 6 P.M. Mr. Smith then went to the store. " +
+	"Please turn to p. 55. Were Jane and co. at the party? They closed the deal with Pitt, Briggs & Co. at noon. " +
+	"I can see Mt. Fuji from here. St. Michael's Church is on 5th st. near the light. That is JFK Jr.'s book. " +
+	"I visited the U.S.A. last year. I live in the E.U. How about you? I work for the U.S. Government in Virginia. " +
+	"Dr. Jones, Prof. Brown and Gen. Lee met Sen. Adams and Rep. Clark, i.e. the committee, on Jan. 3 at the Univ. of Calif."
+
+func BenchmarkAbbreviationReplacer_Replace(b *testing.B) {
+	a := NewAbbreviationReplacer(processor.Standard())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Replace(benchmarkAbbreviationText)
+	}
+}
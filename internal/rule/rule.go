@@ -24,6 +24,10 @@ func (r Rule) Pattern() *regexp.Regexp {
 	return r.pattern
 }
 
+func (r Rule) Replacement() string {
+	return r.replacement
+}
+
 func (r Rules) Apply(text string) string {
 	v := text
 	for _, rr := range r {
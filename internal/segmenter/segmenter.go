@@ -1,9 +1,10 @@
 package segmenter
 
 import (
-	"fmt"
-	"regexp"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/gosbd/gosbd/internal/cleaner"
 	"github.com/gosbd/gosbd/internal/processor"
 )
 
@@ -11,6 +12,7 @@ type Segmenter struct {
 	cfg       *processor.Config
 	processor Processor
 	cleaner   Cleaner
+	offsets   Offsets
 }
 
 type Processor interface {
@@ -18,27 +20,35 @@ type Processor interface {
 }
 
 type Cleaner interface {
-	Clean(text string) string
+	Clean(text string) (string, *cleaner.OffsetMap)
 }
 
+// Offsets is a set of the offsets reported by TextSpans in addition to the byte offsets.
+type Offsets int
+
+const (
+	// OffsetsRunes reports offsets in runes, i.e. Unicode code points.
+	OffsetsRunes Offsets = 1 << iota
+	// OffsetsUTF16 reports offsets in UTF-16 code units, as used by JavaScript strings.
+	OffsetsUTF16
+)
+
 type TextSpan struct {
-	Start    int
-	End      int
+	// Start and End are byte offsets in the original text.
+	Start int
+	End   int
+	// Sentence is the sentence as it appears in the cleaned text if a cleaner is set.
 	Sentence string
+	// RuneStart and RuneEnd are offsets in runes, set if OffsetsRunes is requested.
+	RuneStart int
+	RuneEnd   int
+	// UTF16Start and UTF16End are offsets in UTF-16 code units, set if OffsetsUTF16 is requested.
+	UTF16Start int
+	UTF16End   int
 }
 
 func (sg *Segmenter) Segment(text string) []string {
-	if len(text) == 0 {
-		return nil
-	}
-	if sg.cleaner != nil {
-		text = sg.cleaner.Clean(text)
-	}
-	postProcessedSents := sg.processor.Process(text)
-	if sg.cleaner != nil {
-		return postProcessedSents
-	}
-	spans := sg.sentencesWithCharSpans(postProcessedSents, text)
+	spans := sg.spans(text)
 	var sentences []string
 	for _, span := range spans {
 		sentences = append(sentences, span.Sentence)
@@ -47,20 +57,68 @@ func (sg *Segmenter) Segment(text string) []string {
 }
 
 func (sg *Segmenter) TextSpans(text string) []TextSpan {
+	spans := sg.spans(text)
+	if sg.offsets != 0 {
+		setOffsets(spans, text, sg.offsets)
+	}
+	return spans
+}
+
+// spans segments text and returns the spans of the sentences with byte offsets.
+// If a cleaner is set, the sentences are aligned with the cleaned text
+// and their offsets are mapped back to the original text.
+func (sg *Segmenter) spans(text string) []TextSpan {
 	if len(text) == 0 {
 		return nil
 	}
-	postProcessedSents := sg.processor.Process(text)
-	return sg.sentencesWithCharSpans(postProcessedSents, text)
+	if sg.cleaner == nil {
+		return sg.sentencesWithCharSpans(sg.processor.Process(text), text)
+	}
+	cleaned, offsetMap := sg.cleaner.Clean(text)
+	sentences := sg.processor.Process(cleaned)
+	cleaned, offsetMap = cleaner.RestorePlaceholders(cleaned, offsetMap)
+	spans := sg.sentencesWithCharSpans(sentences, cleaned)
+	for i := range spans {
+		spans[i].Start = offsetMap.Start(spans[i].Start)
+		spans[i].End = offsetMap.End(spans[i].End)
+	}
+	return spans
+}
+
+// Offsets returns the offsets reported by TextSpans in addition to the byte offsets.
+func (sg *Segmenter) Offsets() Offsets {
+	return sg.offsets
 }
 
+// sentencesWithCharSpans aligns the processed sentences with the original text.
+// Each sentence is searched forward from the end of the prior sentence,
+// so the original text is walked once.
+// Like a search for all the non-overlapping matches from the start of the text,
+// a sentence overlapping with a prior match of itself is skipped.
 func (sg *Segmenter) sentencesWithCharSpans(sentences []string, original string) []TextSpan {
 	var spans []TextSpan
 	priorEndCharIdx := 0
 	for _, sent := range sentences {
-		re := regexp.MustCompile(fmt.Sprintf(`%s\s*`, regexp.QuoteMeta(sent)))
-		for _, match := range re.FindAllStringIndex(original, -1) {
-			matchStartIdx, matchEndIdx := match[0], match[1]
+		if sent == "" {
+			continue
+		}
+		// the prior span ends before a non-whitespace character, so a match
+		// ending after it must start less than len(sent) characters before it.
+		from := priorEndCharIdx - len(sent) + 1
+		if from < 0 || isSpace(sent[0]) {
+			from = 0
+		}
+		from = nonOverlappingMatchFrom(original, sent, from)
+		for from <= len(original) {
+			i := strings.Index(original[from:], sent)
+			if i == -1 {
+				break
+			}
+			matchStartIdx := from + i
+			matchEndIdx := matchStartIdx + len(sent)
+			for matchEndIdx < len(original) && isSpace(original[matchEndIdx]) {
+				matchEndIdx++
+			}
 			if matchEndIdx > priorEndCharIdx {
 				// making sure if curren sentence and its span
 				// is either first sentence along with its char spans
@@ -73,15 +131,91 @@ func (sg *Segmenter) sentencesWithCharSpans(sentences []string, original string)
 				priorEndCharIdx = matchEndIdx
 				break
 			}
+			from = matchEndIdx
 		}
 	}
 	return spans
 }
 
+// nonOverlappingMatchFrom moves from back to an index where the non-overlapping matches of sent
+// searched from it are the same as those searched from the start of the text.
+// That is an index no match of sent starting before it overlaps with.
+func nonOverlappingMatchFrom(original, sent string, from int) int {
+	for from > 0 {
+		lo := from - len(sent) + 1
+		if lo < 0 {
+			lo = 0
+		}
+		hi := from + len(sent) - 1
+		if hi > len(original) {
+			hi = len(original)
+		}
+		i := strings.Index(original[lo:hi], sent)
+		if i == -1 || lo+i >= from {
+			break
+		}
+		from = lo + i
+	}
+	return from
+}
+
+// setOffsets converts the byte offsets of the spans to the requested offsets.
+func setOffsets(spans []TextSpan, text string, offsets Offsets) {
+	c := offsetCounter{text: text}
+	for i := range spans {
+		runeStart, utf16Start := c.count(spans[i].Start)
+		runeEnd, utf16End := c.count(spans[i].End)
+		if offsets&OffsetsRunes != 0 {
+			spans[i].RuneStart, spans[i].RuneEnd = runeStart, runeEnd
+		}
+		if offsets&OffsetsUTF16 != 0 {
+			spans[i].UTF16Start, spans[i].UTF16End = utf16Start, utf16End
+		}
+	}
+}
+
+// offsetCounter counts runes and UTF-16 code units up to a byte offset.
+// Offsets are expected in increasing order, so the text is walked once.
+type offsetCounter struct {
+	text  string
+	pos   int
+	runes int
+	utf16 int
+}
+
+func (c *offsetCounter) count(pos int) (int, int) {
+	if pos < c.pos {
+		c.pos, c.runes, c.utf16 = 0, 0, 0
+	}
+	for c.pos < pos {
+		r, size := utf8.DecodeRuneInString(c.text[c.pos:])
+		c.pos += size
+		c.runes++
+		c.utf16++
+		if r >= 0x10000 {
+			// encoded as a surrogate pair
+			c.utf16++
+		}
+	}
+	return c.runes, c.utf16
+}
+
+// isSpace reports whether c is a whitespace as matched by \s.
+func isSpace(c byte) bool {
+	switch c {
+	case '\t', '\n', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
 type Params struct {
 	Config    *processor.Config
 	Processor Processor
 	Cleaner   Cleaner
+	// Clean requests a cleaner, which is built from Config once every option has been applied.
+	Clean   bool
+	Offsets Offsets
 }
 
 func NewSegmenter(params *Params) *Segmenter {
@@ -89,5 +223,6 @@ func NewSegmenter(params *Params) *Segmenter {
 		cfg:       params.Config,
 		processor: params.Processor,
 		cleaner:   params.Cleaner,
+		offsets:   params.Offsets,
 	}
 }
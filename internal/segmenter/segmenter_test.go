@@ -0,0 +1,186 @@
+package segmenter
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// regexpSentencesWithCharSpans is the former implementation of sentencesWithCharSpans,
+// which compiles a regular expression for every sentence. It is kept as a reference
+// for the offsets.
+func regexpSentencesWithCharSpans(sentences []string, original string) []TextSpan {
+	var spans []TextSpan
+	priorEndCharIdx := 0
+	for _, sent := range sentences {
+		re := regexp.MustCompile(fmt.Sprintf(`%s\s*`, regexp.QuoteMeta(sent)))
+		for _, match := range re.FindAllStringIndex(original, -1) {
+			matchStartIdx, matchEndIdx := match[0], match[1]
+			if matchEndIdx > priorEndCharIdx {
+				spans = append(spans, TextSpan{
+					Start:    matchStartIdx,
+					End:      matchEndIdx,
+					Sentence: sent,
+				})
+				priorEndCharIdx = matchEndIdx
+				break
+			}
+		}
+	}
+	return spans
+}
+
+func TestSegmenter_sentencesWithCharSpans(t *testing.T) {
+	type args struct {
+		sentences []string
+		original  string
+	}
+	tests := []struct {
+		args args
+		want []TextSpan
+	}{
+		{
+			args: args{
+				sentences: []string{"Hello World.", "My name is Jonas."},
+				original:  "Hello World. My name is Jonas.",
+			},
+			want: []TextSpan{
+				{Start: 0, End: 13, Sentence: "Hello World."},
+				{Start: 13, End: 30, Sentence: "My name is Jonas."},
+			},
+		},
+		{
+			args: args{
+				sentences: []string{"Hi.", "Hi.", "Hi."},
+				original:  "  Hi.\n\nHi.\tHi.  ",
+			},
+			want: []TextSpan{
+				{Start: 2, End: 7, Sentence: "Hi."},
+				{Start: 7, End: 11, Sentence: "Hi."},
+				{Start: 11, End: 16, Sentence: "Hi."},
+			},
+		},
+		{
+			args: args{
+				sentences: []string{"First.", "Missing.", "Second."},
+				original:  "First. Second.",
+			},
+			want: []TextSpan{
+				{Start: 0, End: 7, Sentence: "First."},
+				{Start: 7, End: 14, Sentence: "Second."},
+			},
+		},
+		{
+			args: args{
+				sentences: []string{"これはペンです。", "それはマーカーです。"},
+				original:  "これはペンです。それはマーカーです。",
+			},
+			want: []TextSpan{
+				{Start: 0, End: 24, Sentence: "これはペンです。"},
+				{Start: 24, End: 54, Sentence: "それはマーカーです。"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args.original, func(t *testing.T) {
+			sg := &Segmenter{}
+			got := sg.sentencesWithCharSpans(tt.args.sentences, tt.args.original)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segmenter.sentencesWithCharSpans() = %#v, want %#v", got, tt.want)
+			}
+			if want := regexpSentencesWithCharSpans(tt.args.sentences, tt.args.original); !reflect.DeepEqual(got, want) {
+				t.Errorf("Segmenter.sentencesWithCharSpans() = %#v, regexp implementation = %#v", got, want)
+			}
+		})
+	}
+}
+
+func TestSegmenter_sentencesWithCharSpansMatchesRegexp(t *testing.T) {
+	words := []string{"a", "b", "a.", "b.", "ab.", " ", "  ", "\n"}
+	rng := rand.New(rand.NewSource(1))
+	sg := &Segmenter{}
+	for i := 0; i < 2000; i++ {
+		var b strings.Builder
+		for j := rng.Intn(20); j >= 0; j-- {
+			b.WriteString(words[rng.Intn(len(words))])
+			if rng.Intn(2) == 0 {
+				b.WriteString(" ")
+			}
+		}
+		original := b.String()
+		var sentences []string
+		for _, s := range strings.SplitAfter(original, ".") {
+			if s = strings.TrimSpace(s); s != "" {
+				sentences = append(sentences, s)
+			}
+		}
+		if rng.Intn(4) == 0 && len(sentences) > 0 {
+			sentences = append(sentences, sentences[rng.Intn(len(sentences))])
+		}
+		got := sg.sentencesWithCharSpans(sentences, original)
+		if want := regexpSentencesWithCharSpans(sentences, original); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Segmenter.sentencesWithCharSpans(%q, %q) = %#v, want %#v", sentences, original, got, want)
+		}
+	}
+}
+
+func TestSegmenter_sentencesWithCharSpansMatchesRegexpOverlapping(t *testing.T) {
+	// sentences made of repeated tokens overlap with themselves and with each other.
+	chars := []string{"a", "a", "b", ".", " "}
+	tokens := []string{"a", "aa", "aaa", "aba", "a.", "a a", "aa."}
+	rng := rand.New(rand.NewSource(1))
+	sg := &Segmenter{}
+	for i := 0; i < 5000; i++ {
+		var b strings.Builder
+		for j := rng.Intn(16); j >= 0; j-- {
+			b.WriteString(chars[rng.Intn(len(chars))])
+		}
+		original := b.String()
+		var sentences []string
+		for j := rng.Intn(6); j >= 0; j-- {
+			sentences = append(sentences, tokens[rng.Intn(len(tokens))])
+		}
+		got := sg.sentencesWithCharSpans(sentences, original)
+		if want := regexpSentencesWithCharSpans(sentences, original); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Segmenter.sentencesWithCharSpans(%q, %q) = %#v, want %#v", sentences, original, got, want)
+		}
+	}
+}
+
+func benchmarkSentencesWithCharSpans(b *testing.B, size int) {
+	sentences := []string{
+		"Hello World.",
+		"My name is Jonas.",
+		"What is your name?",
+		"There it is!",
+		"I found it.",
+	}
+	var (
+		text  strings.Builder
+		sents []string
+	)
+	for i := 0; text.Len() < size; i++ {
+		sent := sentences[i%len(sentences)]
+		sents = append(sents, sent)
+		text.WriteString(sent)
+		text.WriteString(" ")
+	}
+	original := text.String()
+	sg := &Segmenter{}
+	b.SetBytes(int64(len(original)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sg.sentencesWithCharSpans(sents, original)
+	}
+}
+
+func BenchmarkSegmenter_sentencesWithCharSpans1MB(b *testing.B) {
+	benchmarkSentencesWithCharSpans(b, 1<<20)
+}
+
+func BenchmarkSegmenter_sentencesWithCharSpans10MB(b *testing.B) {
+	benchmarkSentencesWithCharSpans(b, 10<<20)
+}
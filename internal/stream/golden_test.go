@@ -0,0 +1,101 @@
+package stream_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gosbd/gosbd"
+)
+
+// goldenLanguages maps the golden tests of the lang package to their language code.
+var goldenLanguages = map[string]string{
+	"Test_Amharic":  "am",
+	"Test_Arabic":   "ar",
+	"Test_Armenian": "hy",
+	"Test_Burmese":  "my",
+	"Test_Chinese":  "zh",
+	"Test_Danish":   "da",
+	"Test_Dutch":    "nl",
+	"Test_English":  "en",
+	"Test_French":   "fr",
+	"Test_German":   "de",
+	"Test_Greek":    "el",
+	"Test_Hindi":    "hi",
+	"Test_Italian":  "it",
+	"Test_Japanese": "ja",
+	"Test_Marathi":  "mr",
+	"Test_Persian":  "fa",
+	"Test_Polish":   "pl",
+	"Test_Russian":  "ru",
+	"Test_Spanish":  "es",
+	"Test_Urdu":     "ur",
+}
+
+// goldenTexts returns the texts of the golden tests of the lang package by language code.
+func goldenTexts(t *testing.T) map[string][]string {
+	t.Helper()
+	files, err := filepath.Glob("../lang/*_test.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	texts := make(map[string][]string)
+	fset := token.NewFileSet()
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			lang, ok := goldenLanguages[fn.Name.Name]
+			if !ok {
+				continue
+			}
+			ast.Inspect(fn, func(n ast.Node) bool {
+				kv, ok := n.(*ast.KeyValueExpr)
+				if !ok {
+					return true
+				}
+				key, ok := kv.Key.(*ast.Ident)
+				lit, isLit := kv.Value.(*ast.BasicLit)
+				if !ok || key.Name != "text" || !isLit || lit.Kind != token.STRING {
+					return true
+				}
+				text, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					t.Fatal(err)
+				}
+				texts[lang] = append(texts[lang], text)
+				return false
+			})
+		}
+	}
+	if len(texts) != len(goldenLanguages) {
+		t.Fatalf("found golden tests of %d languages, want %d", len(texts), len(goldenLanguages))
+	}
+	return texts
+}
+
+func TestReader_ReadGolden(t *testing.T) {
+	for lang, texts := range goldenTexts(t) {
+		sg := gosbd.NewSegmenter(lang)
+		for _, text := range texts {
+			want := sg.TextSpans(text)
+			for _, readSize := range []int{1, 3, 7, 16, 40} {
+				r := gosbd.NewReader(sg, strings.NewReader(text), gosbd.ReadSize(readSize))
+				if got := readAll(t, r); !reflect.DeepEqual(got, want) {
+					t.Errorf("%s ReadSize(%d): Reader.Read(%q) = %#v, want %#v", lang, readSize, text, got, want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,436 @@
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gosbd/gosbd/internal/segmenter"
+)
+
+const (
+	DefaultReadSize    = 32 * 1024
+	DefaultMaxTailSize = 64 * 1024
+)
+
+type Segmenter interface {
+	TextSpans(text string) []segmenter.TextSpan
+}
+
+// offsetsReporter is implemented by segmenters which report offsets
+// in addition to byte offsets.
+type offsetsReporter interface {
+	Offsets() segmenter.Offsets
+}
+
+// closingPunctuation maps opening quotes and brackets to their closing counterpart.
+// Sentences following an unclosed opening punctuation aren't resolved,
+// since the closing punctuation may turn their boundaries into non boundaries.
+var closingPunctuation = map[rune]rune{
+	'"': '"',
+	'(': ')',
+	'[': ']',
+	'“': '”',
+	'«': '»',
+	'「': '」',
+	'（': '）',
+	'《': '》',
+}
+
+// Resolve returns the leading spans of the text whose boundaries don't change
+// when more text is appended to it. Every span is resolved at EOF.
+// The last sentence, and the sentences from an unclosed quote, a trailing run of terminators
+// or the second to last list item marker onwards are left unresolved, unless the text is longer than maxTail.
+// The returned index is where the unresolved text starts, which is the start of the first unresolved sentence,
+// as the text between the sentences which isn't part of any, e.g. the last period of "It was odd...... The end.",
+// belongs to neither of them.
+func Resolve(sg Segmenter, text string, atEOF bool, maxTail int) ([]segmenter.TextSpan, int) {
+	if atEOF {
+		return sg.TextSpans(text), len(text)
+	}
+	cut := cutIndex(text, maxTail)
+	if cut == 0 {
+		return nil, 0
+	}
+	spans := sg.TextSpans(text[:cut])
+	if len(spans) == 0 {
+		return nil, 0
+	}
+	n := len(spans) - 1
+	// a sentence without letters, e.g. the numbered reference "[1]" or ". ." of a spaced ellipsis,
+	// may be joined to the sentence before it by the text following it,
+	// and so may a sentence starting with terminators, e.g. ". . . The practice".
+	for n > 0 && (!hasLetter(spans[n].Sentence) || startsWithTerminator(spans[n].Sentence)) {
+		n--
+	}
+	// more terminators may follow the ones the text ends with, e.g. "..." after "Intro.."
+	// or ". . ." after ". .", which changes the boundaries of the sentences ending within them.
+	if run := trailingTerminatorsStart(text[:cut]); run != cut {
+		for n > 0 && spans[n-1].Start+len(spans[n-1].Sentence) > run {
+			n--
+		}
+	}
+	if open := unclosedPunctuation(text[:cut]); open != -1 {
+		for n > 0 && spans[n-1].End > open {
+			n--
+		}
+	}
+	// a marker is only taken for a list item if the markers around it continue the list,
+	// e.g. "a." and "b." in "a. The first item b. The second item", so the last two are kept.
+	if marker := listItemMarkersStart(text[:cut]); marker != -1 {
+		for n > 0 && spans[n-1].End > marker {
+			n--
+		}
+	}
+	if n == 0 && len(text) > maxTail {
+		n = len(spans) - 1
+		if n == 0 {
+			n = 1
+		}
+	}
+	if n == 0 {
+		return nil, 0
+	}
+	resolved := spans[n-1].End
+	if n < len(spans) && spans[n].Start > resolved {
+		resolved = spans[n].Start
+	}
+	return spans[:n], resolved
+}
+
+// cutIndex returns the end of the part of the text which is segmented before EOF.
+// The word at the end of the text may be incomplete, and the boundary
+// before it depends on it, so only the text up to the last whitespace is segmented.
+// Texts written without whitespace, e.g. Chinese or Japanese, and texts longer than maxTail
+// are cut after the last complete rune.
+func cutIndex(text string, maxTail int) int {
+	cut := lastWhitespaceEnd(text)
+	if cut == 0 && (len(text) > maxTail || isWrittenWithoutSpaces(text)) {
+		cut = lastCompleteRuneEnd(text)
+	}
+	return cut
+}
+
+// resolver resolves the spans of a growing text. The text isn't segmented again
+// while the part of it which is segmented stays the same and nothing is resolved,
+// so reading a long sentence in small pieces doesn't segment it over and over.
+type resolver struct {
+	sg      Segmenter
+	maxTail int
+	// cut is the end of the text segmented by the last call if it resolved nothing, or -1.
+	cut int
+}
+
+func newResolver(sg Segmenter, maxTail int) *resolver {
+	return &resolver{sg: sg, maxTail: maxTail, cut: -1}
+}
+
+// resolve returns Resolve(text, atEOF). The text must be the text of the last call
+// with more text appended to it, unless the last call resolved some spans.
+func (r *resolver) resolve(text string, atEOF bool) ([]segmenter.TextSpan, int) {
+	cut := cutIndex(text, r.maxTail)
+	if !atEOF && cut == r.cut && len(text) <= r.maxTail {
+		return nil, 0
+	}
+	spans, resolved := Resolve(r.sg, text, atEOF, r.maxTail)
+	r.cut = -1
+	if len(spans) == 0 {
+		r.cut = cut
+	}
+	return spans, resolved
+}
+
+// lastWhitespaceEnd returns the index following the last whitespace of the text,
+// or 0 if the text has no whitespace.
+func lastWhitespaceEnd(text string) int {
+	for i := len(text); i > 0; {
+		r, size := utf8.DecodeLastRuneInString(text[:i])
+		if unicode.IsSpace(r) {
+			return i
+		}
+		i -= size
+	}
+	return 0
+}
+
+// isWrittenWithoutSpaces reports whether the text contains letters of a script
+// which doesn't separate words with spaces.
+func isWrittenWithoutSpaces(text string) bool {
+	for _, r := range text {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Thai, unicode.Lao, unicode.Khmer, unicode.Myanmar) {
+			return true
+		}
+	}
+	return false
+}
+
+// trailingTerminatorsStart returns the index of the run of sentence terminators,
+// which may be separated by whitespace as in ". . .", the text ends with,
+// or the length of the text if it doesn't end with one.
+func trailingTerminatorsStart(text string) int {
+	start := len(text)
+	for i := len(text); i > 0; {
+		r, size := utf8.DecodeLastRuneInString(text[:i])
+		if unicode.Is(unicode.Terminal_Punctuation, r) {
+			start = i - size
+		} else if !unicode.IsSpace(r) {
+			break
+		}
+		i -= size
+	}
+	return start
+}
+
+var (
+	// listItemMarkerRegex matches the candidates for the markers of list items recognized
+	// by the list item replacer, e.g. "a.", "(iv)" or "9.".
+	listItemMarkerRegex = regexp.MustCompile(`\(?([a-z]+)[.)]|\d{1,2}[.)]`)
+	// listItemLetterRegex matches the letters and roman numerals of list items.
+	listItemLetterRegex = regexp.MustCompile(`\A(?:[a-z]|[ivx]+)\z`)
+)
+
+// listItemMarkersStart returns the index of the second to last candidate for a list item marker,
+// the index of the only one, or -1 if the text has none.
+func listItemMarkersStart(text string) int {
+	start := -1
+	found := 0
+	matches := listItemMarkerRegex.FindAllStringSubmatchIndex(text, -1)
+	for i := len(matches) - 1; i >= 0 && found < 2; i-- {
+		m := matches[i]
+		if m[2] == -1 || listItemLetterRegex.MatchString(text[m[2]:m[3]]) {
+			start = m[0]
+			found++
+		}
+	}
+	return start
+}
+
+// hasLetter reports whether the text contains a letter.
+func hasLetter(text string) bool {
+	return strings.IndexFunc(text, unicode.IsLetter) != -1
+}
+
+// startsWithTerminator reports whether the text starts with a sentence terminator.
+func startsWithTerminator(text string) bool {
+	r, _ := utf8.DecodeRuneInString(text)
+	return unicode.Is(unicode.Terminal_Punctuation, r)
+}
+
+// lastCompleteRuneEnd returns the length of the text without a trailing incomplete rune.
+func lastCompleteRuneEnd(text string) int {
+	for i := len(text) - 1; i >= 0 && i >= len(text)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(text[i]) {
+			if !utf8.FullRuneInString(text[i:]) {
+				return i
+			}
+			break
+		}
+	}
+	return len(text)
+}
+
+// unclosedPunctuation returns the index of the first opening quote or bracket
+// which isn't closed, or -1 if every one of them is closed.
+func unclosedPunctuation(text string) int {
+	type opening struct {
+		closing rune
+		idx     int
+	}
+	var stack []opening
+	prev := ' '
+	for i, r := range text {
+		before := prev
+		prev = r
+		if r == '\'' {
+			// a single quote is also an apostrophe, e.g. in "c'è" or "Let's", so it only opens
+			// a quotation after a whitespace and only closes one before a non-letter.
+			next, _ := utf8.DecodeRuneInString(text[i+1:])
+			if len(stack) > 0 && stack[len(stack)-1].closing == r && !unicode.IsLetter(next) {
+				stack = stack[:len(stack)-1]
+			} else if unicode.IsSpace(before) || closingPunctuation[before] != 0 {
+				stack = append(stack, opening{closing: r, idx: i})
+			}
+			continue
+		}
+		if len(stack) > 0 && stack[len(stack)-1].closing == r {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		if closing, ok := closingPunctuation[r]; ok {
+			stack = append(stack, opening{closing: closing, idx: i})
+		}
+	}
+	if len(stack) == 0 {
+		return -1
+	}
+	return stack[0].idx
+}
+
+// Reader reads sentences from an io.Reader. Only the unresolved tail of the
+// stream is kept in memory.
+type Reader struct {
+	r        io.Reader
+	resolver *resolver
+	readSize int
+	buf      []byte
+	// offset is the position of buf in the stream, runeOffset and utf16Offset
+	// are the same position in runes and UTF-16 code units.
+	offset      int
+	offsets     segmenter.Offsets
+	runeOffset  int
+	utf16Offset int
+	pending     []segmenter.TextSpan
+	err         error
+}
+
+// Read returns the next sentence with its absolute byte offsets in the stream.
+// It returns io.EOF when there are no more sentences.
+func (r *Reader) Read() (segmenter.TextSpan, error) {
+	for len(r.pending) == 0 {
+		if r.err != nil {
+			return segmenter.TextSpan{}, r.err
+		}
+		r.fill()
+	}
+	span := r.pending[0]
+	r.pending = r.pending[1:]
+	return span, nil
+}
+
+func (r *Reader) fill() {
+	n := len(r.buf)
+	if cap(r.buf)-n < r.readSize {
+		buf := make([]byte, n, n+r.readSize)
+		copy(buf, r.buf)
+		r.buf = buf
+	}
+	read, err := r.r.Read(r.buf[n : n+r.readSize])
+	r.buf = r.buf[:n+read]
+	if err != nil && err != io.EOF {
+		r.err = err
+		return
+	}
+	atEOF := err == io.EOF
+	if read == 0 && !atEOF {
+		return
+	}
+	spans, consumed := r.resolver.resolve(string(r.buf), atEOF)
+	for _, span := range spans {
+		span.Start += r.offset
+		span.End += r.offset
+		if r.offsets&segmenter.OffsetsRunes != 0 {
+			span.RuneStart += r.runeOffset
+			span.RuneEnd += r.runeOffset
+		}
+		if r.offsets&segmenter.OffsetsUTF16 != 0 {
+			span.UTF16Start += r.utf16Offset
+			span.UTF16End += r.utf16Offset
+		}
+		r.pending = append(r.pending, span)
+	}
+	if atEOF {
+		r.buf = nil
+		r.err = io.EOF
+		return
+	}
+	if r.offsets != 0 {
+		for _, c := range string(r.buf[:consumed]) {
+			r.runeOffset++
+			r.utf16Offset++
+			if c >= 0x10000 {
+				r.utf16Offset++
+			}
+		}
+	}
+	r.buf = r.buf[:copy(r.buf, r.buf[consumed:])]
+	r.offset += consumed
+}
+
+// SplitFunc returns a bufio.SplitFunc which yields sentences once their boundary
+// is certain. Tokens are the sentences of the original text without trailing whitespace,
+// and without the part of a sentence overlapping the prior one.
+// The returned function keeps the sentences resolved from the last data it was
+// called with, so it must not be shared by several scanners.
+func SplitFunc(sg Segmenter, maxTail int) bufio.SplitFunc {
+	var (
+		resolver = newResolver(sg, maxTail)
+		pending  []segmenter.TextSpan
+		// advanced is the number of bytes consumed since pending was resolved,
+		// and resolved is where the text left unresolved starts.
+		advanced, resolved int
+	)
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		// a span may overlap the prior one, e.g. "....." following "It was odd."
+		// in "It was odd...... The end.", so the spans consumed already are skipped.
+		for len(pending) > 0 && pending[0].End <= advanced {
+			pending = pending[1:]
+		}
+		if len(pending) == 0 && advanced < resolved {
+			// the text up to the unresolved sentence isn't part of a resolved one
+			advance := resolved - advanced
+			advanced = resolved
+			return advance, nil, nil
+		}
+		if len(pending) == 0 {
+			if atEOF && len(data) == 0 {
+				return 0, nil, nil
+			}
+			pending, resolved = resolver.resolve(string(data), atEOF)
+			advanced = 0
+			if len(pending) == 0 {
+				if atEOF {
+					// only whitespace is left
+					return len(data), nil, nil
+				}
+				return 0, nil, nil
+			}
+		}
+		span := pending[0]
+		pending = pending[1:]
+		start, end := span.Start-advanced, span.End-advanced
+		if start < 0 {
+			start = 0
+		}
+		advanced = span.End
+		token := bytes.TrimRightFunc(data[start:end], unicode.IsSpace)
+		if len(token) == 0 {
+			return end, nil, nil
+		}
+		return end, token, nil
+	}
+}
+
+type Params struct {
+	Segmenter Segmenter
+	// ReadSize is the number of bytes read from the stream at once.
+	ReadSize int
+	// MaxTailSize bounds the unresolved text kept in memory.
+	// Sentences are resolved regardless of unclosed quotes, and text without whitespace
+	// is cut, once it is exceeded.
+	MaxTailSize int
+}
+
+func NewReader(r io.Reader, params *Params) *Reader {
+	readSize := params.ReadSize
+	if readSize <= 0 {
+		readSize = DefaultReadSize
+	}
+	maxTail := params.MaxTailSize
+	if maxTail <= 0 {
+		maxTail = DefaultMaxTailSize
+	}
+	var offsets segmenter.Offsets
+	if o, ok := params.Segmenter.(offsetsReporter); ok {
+		offsets = o.Offsets()
+	}
+	return &Reader{
+		r:        r,
+		resolver: newResolver(params.Segmenter, maxTail),
+		readSize: readSize,
+		offsets:  offsets,
+	}
+}
@@ -0,0 +1,261 @@
+package stream_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/gosbd/gosbd"
+	"github.com/gosbd/gosbd/internal/segmenter"
+)
+
+func readAll(t *testing.T, r gosbd.Reader) []segmenter.TextSpan {
+	t.Helper()
+	var spans []segmenter.TextSpan
+	for {
+		span, err := r.Read()
+		if err == io.EOF {
+			return spans
+		}
+		if err != nil {
+			t.Fatalf("Reader.Read() error = %v", err)
+		}
+		spans = append(spans, span)
+	}
+}
+
+func TestReader_Read(t *testing.T) {
+	type args struct {
+		lang string
+		text string
+	}
+	tests := []struct {
+		args args
+	}{
+		{
+			args: args{
+				lang: "en",
+				text: "Hello World. My name is Jonas. What is your name? My name is Jonas. There it is! I found it.",
+			},
+		},
+		{
+			args: args{
+				lang: "en",
+				text: "I work for the U.S. Government in Virginia. I have lived in the U.S. for 20 years. Were Jane and co. at the party?",
+			},
+		},
+		{
+			args: args{
+				lang: "en",
+				text: `She turned to him, "This is great. Really great." She held the book out to show him. I can see Mt. Fuji from here.`,
+			},
+		},
+		{
+			args: args{
+				lang: "en",
+				text: "1.) The first item 2.) The second item\n\nAnother paragraph starts here.  And it ends here.\n",
+			},
+		},
+		{
+			args: args{
+				lang: "ja",
+				text: "これはペンです。それはマーカーです。それは何ですか？ペンですか？良かったね！すごい！",
+			},
+		},
+		{
+			args: args{
+				lang: "ru",
+				text: "Объем составляет 5 куб.м. Маленькая девочка бежала и кричала: «Не видали маму?». Сегодня 27.10.14",
+			},
+		},
+		{
+			args: args{
+				lang: "en",
+				text: "Intro.......... 3",
+			},
+		},
+		{
+			args: args{
+				lang: "zh",
+				text: "我们走吧！！好的。他说：“再见。”",
+			},
+		},
+		{
+			args: args{
+				lang: "en",
+				text: "It was odd...... The end.",
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, sg := range []gosbd.Segmenter{
+			gosbd.NewSegmenter(tt.args.lang),
+			gosbd.NewSegmenter(tt.args.lang, gosbd.RuneOffsets(), gosbd.UTF16Offsets()),
+		} {
+			want := sg.TextSpans(tt.args.text)
+			for readSize := 1; readSize <= 64; readSize++ {
+				t.Run(tt.args.text, func(t *testing.T) {
+					r := gosbd.NewReader(sg, strings.NewReader(tt.args.text), gosbd.ReadSize(readSize))
+					if got := readAll(t, r); !reflect.DeepEqual(got, want) {
+						t.Errorf("ReadSize(%d): Reader.Read() = %#v, want %#v", readSize, got, want)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestReader_ReadOneByte(t *testing.T) {
+	text := "Let's ask Jane and co. They should know. At 5 p.m. we leave."
+	sg := gosbd.NewSegmenter("en")
+	r := gosbd.NewReader(sg, iotest.OneByteReader(strings.NewReader(text)))
+	if got, want := readAll(t, r), sg.TextSpans(text); !reflect.DeepEqual(got, want) {
+		t.Errorf("Reader.Read() = %#v, want %#v", got, want)
+	}
+}
+
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += n
+	return n, err
+}
+
+func TestReader_MaxTailSize(t *testing.T) {
+	// the unclosed quote holds back every sentence until the tail size is exceeded
+	text := `He said "Hello. ` + strings.Repeat("This is long. ", 10)
+	sg := gosbd.NewSegmenter("en")
+	cr := &countingReader{r: strings.NewReader(text)}
+	r := gosbd.NewReader(sg, cr, gosbd.ReadSize(8), gosbd.MaxTailSize(32))
+	span, err := r.Read()
+	if err != nil {
+		t.Fatalf("Reader.Read() error = %v", err)
+	}
+	if want := sg.TextSpans(text)[0]; span != want {
+		t.Errorf("Reader.Read() = %#v, want %#v", span, want)
+	}
+	if cr.n > 32+8 {
+		t.Errorf("Reader.Read() read %d bytes, want at most %d", cr.n, 32+8)
+	}
+}
+
+func TestReader_MaxTailSizeWithoutWhitespace(t *testing.T) {
+	text := strings.Repeat("x", 1<<20)
+	sg := gosbd.NewSegmenter("en")
+	cr := &countingReader{r: strings.NewReader(text)}
+	r := gosbd.NewReader(sg, cr, gosbd.ReadSize(512), gosbd.MaxTailSize(4096))
+	span, err := r.Read()
+	if err != nil {
+		t.Fatalf("Reader.Read() error = %v", err)
+	}
+	if span.Start != 0 || span.End > 4096+512 {
+		t.Errorf("Reader.Read() = [%d, %d), want a span within [0, %d)", span.Start, span.End, 4096+512)
+	}
+	if cr.n > 4096+512 {
+		t.Errorf("Reader.Read() read %d bytes, want at most %d", cr.n, 4096+512)
+	}
+}
+
+func TestReader_ReadWithoutSpaces(t *testing.T) {
+	// Burmese separates sentences, not words, with spaces
+	text := strings.Repeat("ကျွန်တော်ကျောင်းသွားတယ်။", 1000)
+	sg := gosbd.NewSegmenter("my")
+	cr := &countingReader{r: strings.NewReader(text)}
+	r := gosbd.NewReader(sg, cr, gosbd.ReadSize(64))
+	span, err := r.Read()
+	if err != nil {
+		t.Fatalf("Reader.Read() error = %v", err)
+	}
+	if want := sg.TextSpans(text)[0]; span != want {
+		t.Errorf("Reader.Read() = %#v, want %#v", span, want)
+	}
+	if cr.n > 256 {
+		t.Errorf("Reader.Read() read %d bytes, want at most %d", cr.n, 256)
+	}
+}
+
+func TestReader_ReadError(t *testing.T) {
+	errRead := errors.New("read error")
+	sg := gosbd.NewSegmenter("en")
+	r := gosbd.NewReader(sg, iotest.ErrReader(errRead))
+	if _, err := r.Read(); !errors.Is(err, errRead) {
+		t.Errorf("Reader.Read() error = %v, want %v", err, errRead)
+	}
+}
+
+func TestSplitFunc(t *testing.T) {
+	type args struct {
+		lang string
+		text string
+	}
+	tests := []struct {
+		args args
+		want []string
+	}{
+		{
+			args: args{
+				lang: "en",
+				text: "Hello World. My name is Jonas. What is your name? My name is Jonas.",
+			},
+			want: []string{"Hello World.", "My name is Jonas.", "What is your name?", "My name is Jonas."},
+		},
+		{
+			args: args{
+				lang: "en",
+				text: "  I work for the U.S. Government in Virginia.\n\nLet's ask Jane and co. They should know.\n",
+			},
+			want: []string{"I work for the U.S. Government in Virginia.", "Let's ask Jane and co.", "They should know."},
+		},
+		{
+			args: args{
+				lang: "ja",
+				text: "これはペンです。それはマーカーです。",
+			},
+			want: []string{"これはペンです。", "それはマーカーです。"},
+		},
+		{
+			args: args{
+				lang: "en",
+				text: "   ",
+			},
+		},
+		{
+			args: args{
+				lang: "en",
+				text: "It was odd...... The end.",
+			},
+			want: []string{"It was odd.", "....", "The end."},
+		},
+	}
+	readers := map[string]func(io.Reader) io.Reader{
+		"whole":    func(r io.Reader) io.Reader { return r },
+		"one byte": iotest.OneByteReader,
+		"half":     iotest.HalfReader,
+	}
+	for _, tt := range tests {
+		for name, reader := range readers {
+			t.Run(name+" "+tt.args.text, func(t *testing.T) {
+				scanner := bufio.NewScanner(reader(strings.NewReader(tt.args.text)))
+				scanner.Split(gosbd.ScanSentences(tt.args.lang))
+				var got []string
+				for scanner.Scan() {
+					got = append(got, scanner.Text())
+				}
+				if err := scanner.Err(); err != nil {
+					t.Fatalf("Scanner.Err() = %v", err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Scanner.Text() = %#v, want %#v", got, tt.want)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package gosbd
+
+import (
+	"strings"
+
+	"github.com/gosbd/gosbd/internal/segmenter"
+)
+
+// AddAbbreviations adds abbreviations whose trailing period doesn't end a sentence,
+// e.g. "approx" or "Sec.". Abbreviations are case-insensitive and the trailing period is optional.
+func AddAbbreviations(abbrs ...string) Option {
+	return func(params *segmenter.Params) {
+		abbr := &params.Config.Abbreviation
+		abbr.Abbreviations = addWords(abbr.Abbreviations, normalizeAbbreviations(abbrs))
+	}
+}
+
+// RemoveAbbreviations removes abbreviations from the language defaults.
+// The abbreviations are also removed from the pre-positive and number abbreviations.
+func RemoveAbbreviations(abbrs ...string) Option {
+	return func(params *segmenter.Params) {
+		abbr := &params.Config.Abbreviation
+		normalized := normalizeAbbreviations(abbrs)
+		abbr.Abbreviations = removeWords(abbr.Abbreviations, normalized)
+		abbr.PrePositiveAbbreviations = removeWords(abbr.PrePositiveAbbreviations, normalized)
+		abbr.NumberAbbreviations = removeWords(abbr.NumberAbbreviations, normalized)
+	}
+}
+
+// SetAbbreviations replaces the language defaults with the given abbreviations.
+// Pre-positive and number abbreviations which aren't in the new list are dropped.
+func SetAbbreviations(abbrs ...string) Option {
+	return func(params *segmenter.Params) {
+		abbr := &params.Config.Abbreviation
+		abbr.Abbreviations = addWords(nil, normalizeAbbreviations(abbrs))
+		abbr.PrePositiveAbbreviations = keepWords(abbr.PrePositiveAbbreviations, abbr.Abbreviations)
+		abbr.NumberAbbreviations = keepWords(abbr.NumberAbbreviations, abbr.Abbreviations)
+	}
+}
+
+// AddPrePositiveAbbreviations adds abbreviations which precede a capitalized word
+// without ending the sentence, e.g. "Sec" in "Sec. Smith". They are added to the
+// abbreviations as well.
+func AddPrePositiveAbbreviations(abbrs ...string) Option {
+	return func(params *segmenter.Params) {
+		abbr := &params.Config.Abbreviation
+		normalized := normalizeAbbreviations(abbrs)
+		abbr.Abbreviations = addWords(abbr.Abbreviations, normalized)
+		abbr.PrePositiveAbbreviations = addWords(abbr.PrePositiveAbbreviations, normalized)
+	}
+}
+
+// RemovePrePositiveAbbreviations removes pre-positive abbreviations from the language defaults.
+// They are still treated as ordinary abbreviations.
+func RemovePrePositiveAbbreviations(abbrs ...string) Option {
+	return func(params *segmenter.Params) {
+		abbr := &params.Config.Abbreviation
+		abbr.PrePositiveAbbreviations = removeWords(abbr.PrePositiveAbbreviations, normalizeAbbreviations(abbrs))
+	}
+}
+
+// SetPrePositiveAbbreviations replaces the language default pre-positive abbreviations.
+// They are added to the abbreviations as well.
+func SetPrePositiveAbbreviations(abbrs ...string) Option {
+	return func(params *segmenter.Params) {
+		abbr := &params.Config.Abbreviation
+		normalized := normalizeAbbreviations(abbrs)
+		abbr.Abbreviations = addWords(abbr.Abbreviations, normalized)
+		abbr.PrePositiveAbbreviations = addWords(nil, normalized)
+	}
+}
+
+// AddNumberAbbreviations adds abbreviations which precede a number
+// without ending the sentence, e.g. "No" in "No. 5". They are added to the
+// abbreviations as well.
+func AddNumberAbbreviations(abbrs ...string) Option {
+	return func(params *segmenter.Params) {
+		abbr := &params.Config.Abbreviation
+		normalized := normalizeAbbreviations(abbrs)
+		abbr.Abbreviations = addWords(abbr.Abbreviations, normalized)
+		abbr.NumberAbbreviations = addWords(abbr.NumberAbbreviations, normalized)
+	}
+}
+
+// RemoveNumberAbbreviations removes number abbreviations from the language defaults.
+// They are still treated as ordinary abbreviations.
+func RemoveNumberAbbreviations(abbrs ...string) Option {
+	return func(params *segmenter.Params) {
+		abbr := &params.Config.Abbreviation
+		abbr.NumberAbbreviations = removeWords(abbr.NumberAbbreviations, normalizeAbbreviations(abbrs))
+	}
+}
+
+// SetNumberAbbreviations replaces the language default number abbreviations.
+// They are added to the abbreviations as well.
+func SetNumberAbbreviations(abbrs ...string) Option {
+	return func(params *segmenter.Params) {
+		abbr := &params.Config.Abbreviation
+		normalized := normalizeAbbreviations(abbrs)
+		abbr.Abbreviations = addWords(abbr.Abbreviations, normalized)
+		abbr.NumberAbbreviations = addWords(nil, normalized)
+	}
+}
+
+// AddSentenceStarters adds words which start a new sentence when they follow
+// an abbreviation such as "U.S.". Sentence starters are case-sensitive.
+func AddSentenceStarters(words ...string) Option {
+	return func(params *segmenter.Params) {
+		params.Config.SentenceStarters = addWords(params.Config.SentenceStarters, trimWords(words))
+	}
+}
+
+// RemoveSentenceStarters removes sentence starters from the language defaults.
+func RemoveSentenceStarters(words ...string) Option {
+	return func(params *segmenter.Params) {
+		params.Config.SentenceStarters = removeWords(params.Config.SentenceStarters, trimWords(words))
+	}
+}
+
+// SetSentenceStarters replaces the language default sentence starters.
+func SetSentenceStarters(words ...string) Option {
+	return func(params *segmenter.Params) {
+		params.Config.SentenceStarters = addWords(nil, trimWords(words))
+	}
+}
+
+func normalizeAbbreviations(abbrs []string) []string {
+	normalized := make([]string, 0, len(abbrs))
+	for _, abbr := range abbrs {
+		abbr = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(abbr)), ".")
+		if abbr != "" {
+			normalized = append(normalized, abbr)
+		}
+	}
+	return normalized
+}
+
+func trimWords(words []string) []string {
+	trimmed := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			trimmed = append(trimmed, word)
+		}
+	}
+	return trimmed
+}
+
+func addWords(list []string, words []string) []string {
+	for _, word := range words {
+		if !containsWord(list, word) {
+			list = append(list, word)
+		}
+	}
+	return list
+}
+
+func removeWords(list []string, words []string) []string {
+	var res []string
+	for _, word := range list {
+		if !containsWord(words, word) {
+			res = append(res, word)
+		}
+	}
+	return res
+}
+
+func keepWords(list []string, words []string) []string {
+	var res []string
+	for _, word := range list {
+		if containsWord(words, word) {
+			res = append(res, word)
+		}
+	}
+	return res
+}
+
+func containsWord(list []string, word string) bool {
+	for _, w := range list {
+		if w == word {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package gosbd
+
+import (
+	"bufio"
+
+	"github.com/gosbd/gosbd/internal/stream"
+)
+
+// splitMaxTailSize keeps the unresolved text below the default buffer size of bufio.Scanner.
+const splitMaxTailSize = bufio.MaxScanTokenSize / 2
+
+// ScanSentences returns a split function for a bufio.Scanner that returns each
+// sentence of the text, as segmented by NewSegmenter(langCode, option...).
+// Like NewSegmenter, it panics if the language code isn't supported.
+// The split function must be used by a single Scanner.
+func ScanSentences(langCode string, option ...Option) bufio.SplitFunc {
+	return SplitFunc(NewSegmenter(langCode, option...))
+}
+
+// SplitFunc returns a split function for a bufio.Scanner that returns each
+// sentence of the text as segmented by sg, without its trailing whitespace.
+// A sentence is only returned once more input or EOF makes its boundary certain.
+// The split function must be used by a single Scanner.
+func SplitFunc(sg Segmenter) bufio.SplitFunc {
+	return stream.SplitFunc(sg, splitMaxTailSize)
+}
@@ -0,0 +1,50 @@
+package gosbd
+
+import (
+	"io"
+
+	"github.com/gosbd/gosbd/internal/segmenter"
+	"github.com/gosbd/gosbd/internal/stream"
+)
+
+// Reader reads the sentences of a stream as soon as their boundaries are certain.
+type Reader interface {
+	// Read returns the next sentence of the stream. The offsets of the
+	// returned TextSpan are absolute byte offsets in the stream.
+	// It returns io.EOF when the stream has no more sentences.
+	Read() (segmenter.TextSpan, error)
+}
+
+// ReaderOption is a type that represents a function that modifies the reader parameters.
+type ReaderOption func(*stream.Params)
+
+// ReadSize sets the number of bytes read from the stream at once, 32KiB by default.
+func ReadSize(n int) ReaderOption {
+	return func(params *stream.Params) {
+		params.ReadSize = n
+	}
+}
+
+// MaxTailSize bounds the unresolved text kept in memory, 64KiB by default.
+// Sentences following an unclosed quote or bracket are held back until it is closed,
+// or until the unresolved text exceeds this size. Text without whitespace is then cut
+// regardless of word boundaries.
+func MaxTailSize(n int) ReaderOption {
+	return func(params *stream.Params) {
+		params.MaxTailSize = n
+	}
+}
+
+// NewReader creates a Reader which segments the text read from r with the given Segmenter.
+// Memory is bounded by keeping only the unresolved tail of the stream,
+// i.e. the last sentence, which may continue in the upcoming text, and the
+// sentences following an unclosed quote.
+func NewReader(sg Segmenter, r io.Reader, option ...ReaderOption) Reader {
+	params := &stream.Params{
+		Segmenter: sg,
+	}
+	for _, opt := range option {
+		opt(params)
+	}
+	return stream.NewReader(r, params)
+}